@@ -0,0 +1,359 @@
+package cliconf
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Object is a typed sdkcore structure decoded from a CLI configuration
+type Object struct {
+	// Vdom is the vdom of the object, empty for the global configuration
+	// or the configurations without vdoms
+	Vdom string
+	// Table is the CLI table of the object
+	Table Table
+	// Mkey is the edit key of the object, empty for singleton tables
+	Mkey string
+	// Value is a pointer to the sdkcore structure, such as *forticlient.JSONFirewallObjectAddress
+	Value interface{}
+}
+
+// Objects decodes the known tables of the configuration into sdkcore structures,
+// in the order of the configuration. The unknown tables and attributes are skipped.
+func (c *Config) Objects() ([]Object, error) {
+	types := tableTypes()
+
+	var objs []Object
+	for _, b := range c.Blocks {
+		var err error
+		objs, err = decodeBlock(objs, types, b, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return objs, nil
+}
+
+// decodeBlock appends the objects of a top level block of the given vdom to objs
+func decodeBlock(objs []Object, types map[string]reflect.Type, b *Block, vdom string) ([]Object, error) {
+	switch b.Path {
+	case "vdom":
+		for _, e := range b.Entries {
+			for _, nb := range e.Blocks {
+				var err error
+				objs, err = decodeBlock(objs, types, nb, e.Key)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		return objs, nil
+	case "global":
+		for _, nb := range b.Blocks {
+			var err error
+			objs, err = decodeBlock(objs, types, nb, "")
+			if err != nil {
+				return nil, err
+			}
+		}
+		return objs, nil
+	}
+
+	t, ok := types[b.Path]
+	if !ok {
+		return objs, nil
+	}
+
+	table, _ := Lookup(reflect.New(t).Interface())
+
+	if table.Singleton {
+		v := newObject(t)
+		if err := decodeAttrs(v.Elem(), b.Attrs, b.Blocks, ""); err != nil {
+			return nil, fmt.Errorf("config %s: %s", b.Path, err)
+		}
+		objs = append(objs, Object{Vdom: vdom, Table: table, Value: v.Interface()})
+		return objs, nil
+	}
+
+	for _, e := range b.Entries {
+		v := newObject(t)
+
+		if kv, ok := attribute(v.Elem(), table.Key); ok {
+			if err := setValue(kv, []string{e.Key}); err != nil {
+				return nil, fmt.Errorf("line %d: %s: %s", e.Line, table.Key, err)
+			}
+		}
+
+		if err := decodeAttrs(v.Elem(), e.Attrs, e.Blocks, table.Key); err != nil {
+			return nil, fmt.Errorf("config %s, edit %s: %s", b.Path, e.Key, err)
+		}
+
+		objs = append(objs, Object{Vdom: vdom, Table: table, Mkey: e.Key, Value: v.Interface()})
+	}
+
+	return objs, nil
+}
+
+// Decode fills the sdkcore structure pointed by v from the attributes of an edit entry.
+// The entry key is stored in the key attribute of the table of v, when v has one.
+func Decode(e *Entry, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot decode into %T", v)
+	}
+
+	allocEmbedded(rv.Elem())
+
+	key := ""
+	if t, ok := Lookup(v); ok && !t.Singleton {
+		key = t.Key
+		if kv, ok := attribute(rv.Elem(), key); ok {
+			if err := setValue(kv, []string{e.Key}); err != nil {
+				return fmt.Errorf("line %d: %s: %s", e.Line, key, err)
+			}
+		}
+	}
+
+	return decodeAttrs(rv.Elem(), e.Attrs, e.Blocks, key)
+}
+
+// tableTypes maps the CLI paths to the sdkcore structures.
+// When several structures share a path, the first one by name is used.
+func tableTypes() map[string]reflect.Type {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+
+	names := make([]string, 0, len(tables))
+	byName := make(map[string]reflect.Type, len(tables))
+	for t := range tables {
+		n := t.PkgPath() + "." + t.Name()
+		names = append(names, n)
+		byName[n] = t
+	}
+	sort.Strings(names)
+
+	types := make(map[string]reflect.Type, len(tables))
+	for _, n := range names {
+		t := byName[n]
+		if _, ok := types[tables[t].Path]; !ok {
+			types[tables[t].Path] = t
+		}
+	}
+
+	return types
+}
+
+// newObject allocates a structure of type t with all its embedded structures,
+// the same way the Read API functions do
+func newObject(t reflect.Type) reflect.Value {
+	v := reflect.New(t)
+	allocEmbedded(v.Elem())
+	return v
+}
+
+// allocEmbedded allocates the nil embedded structure pointers of v
+func allocEmbedded(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.Anonymous {
+			continue
+		}
+
+		fv := v.Field(i)
+		if fv.Kind() == reflect.Ptr && fv.IsNil() && fv.CanSet() && sf.Type.Elem().Kind() == reflect.Struct {
+			fv.Set(reflect.New(sf.Type.Elem()))
+		}
+		if fv.Kind() == reflect.Ptr {
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.Struct {
+			allocEmbedded(fv)
+		}
+	}
+}
+
+// decodeAttrs sets the attributes and nested blocks into the structure v, skipping the skip attribute
+func decodeAttrs(v reflect.Value, attrs []*Attr, blocks []*Block, skip string) error {
+	byName := make(map[string]reflect.Value)
+	for _, f := range fields(v) {
+		byName[f.name] = f.value
+	}
+
+	for _, a := range attrs {
+		if a.Name == skip || a.Unset {
+			continue
+		}
+
+		fv, ok := byName[a.Name]
+		if !ok {
+			continue
+		}
+
+		if err := setValue(fv, a.Values); err != nil {
+			return fmt.Errorf("line %d: %s: %s", a.Line, a.Name, err)
+		}
+	}
+
+	for _, b := range blocks {
+		fv, ok := byName[b.Path]
+		if !ok {
+			continue
+		}
+
+		if err := setBlock(fv, b); err != nil {
+			return fmt.Errorf("config %s: %s", b.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// setBlock sets a nested config block into a sub-table slice or a nested structure
+func setBlock(fv reflect.Value, b *Block) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		fv = fv.Elem()
+	}
+
+	switch fv.Kind() {
+	case reflect.Struct:
+		allocEmbedded(fv)
+		return decodeAttrs(fv, b.Attrs, b.Blocks, "")
+	case reflect.Slice:
+	default:
+		return fmt.Errorf("cannot decode a config block into %s", fv.Type())
+	}
+
+	et := fv.Type().Elem()
+	ptr := et.Kind() == reflect.Ptr
+	if ptr {
+		et = et.Elem()
+	}
+	if et.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot decode a config block into %s", fv.Type())
+	}
+
+	s := reflect.MakeSlice(fv.Type(), 0, len(b.Entries))
+	for _, e := range b.Entries {
+		ev := reflect.New(et)
+		allocEmbedded(ev.Elem())
+
+		skip := ""
+		for _, k := range []string{"id", "name"} {
+			if kv, ok := attribute(ev.Elem(), k); ok {
+				if err := setValue(kv, []string{e.Key}); err != nil {
+					return fmt.Errorf("line %d: %s: %s", e.Line, k, err)
+				}
+				skip = k
+				break
+			}
+		}
+
+		if err := decodeAttrs(ev.Elem(), e.Attrs, e.Blocks, skip); err != nil {
+			return fmt.Errorf("edit %s: %s", e.Key, err)
+		}
+
+		if ptr {
+			s = reflect.Append(s, ev)
+		} else {
+			s = reflect.Append(s, ev.Elem())
+		}
+	}
+	fv.Set(s)
+
+	return nil
+}
+
+// setValue sets the values of a set command into the field fv
+func setValue(fv reflect.Value, values []string) error {
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		if u, ok := fv.Interface().(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(strings.Join(values, " ")))
+		}
+		fv = fv.Elem()
+	}
+
+	if fv.CanAddr() {
+		if u, ok := fv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			return u.UnmarshalText([]byte(strings.Join(values, " ")))
+		}
+	}
+
+	switch fv.Kind() {
+	case reflect.Slice:
+		return setList(fv, values)
+	case reflect.Struct:
+		return fmt.Errorf("cannot set a value into %s", fv.Type())
+	}
+
+	return setScalar(fv, strings.Join(values, " "))
+}
+
+// setList sets a value list into a slice, such as a MultValues
+func setList(fv reflect.Value, values []string) error {
+	et := fv.Type().Elem()
+
+	s := reflect.MakeSlice(fv.Type(), 0, len(values))
+	for _, val := range values {
+		ev := reflect.New(et).Elem()
+
+		target := ev
+		if et.Kind() == reflect.Struct && !isTextMarshaler(et) {
+			if et.NumField() != 1 {
+				return fmt.Errorf("cannot set a value list into %s", fv.Type())
+			}
+			target = ev.Field(0)
+		}
+
+		if err := setValue(target, []string{val}); err != nil {
+			return err
+		}
+		s = reflect.Append(s, ev)
+	}
+	fv.Set(s)
+
+	return nil
+}
+
+// setScalar sets a single value into a field of a basic kind
+func setScalar(fv reflect.Value, s string) error {
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", s)
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", s)
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", s)
+		}
+		fv.SetFloat(n)
+	case reflect.Bool:
+		fv.SetBool(s == "enable")
+	default:
+		return fmt.Errorf("cannot set a value into %s", fv.Type())
+	}
+
+	return nil
+}
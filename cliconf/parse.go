@@ -0,0 +1,372 @@
+package cliconf
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Config is the tree of a FortiOS CLI configuration,
+// such as the output of "show full-configuration"
+type Config struct {
+	Blocks []*Block
+}
+
+// Block is a "config" block. Singleton tables hold their attributes directly,
+// other tables hold "edit" entries.
+type Block struct {
+	Path    string
+	Line    int
+	Attrs   []*Attr
+	Blocks  []*Block
+	Entries []*Entry
+	// Deletes lists the keys of the "delete" commands of the block
+	Deletes []string
+}
+
+// Entry is an "edit" entry of a table
+type Entry struct {
+	Key    string
+	Line   int
+	Attrs  []*Attr
+	Blocks []*Block
+}
+
+// Attr is a "set" or "unset" command
+type Attr struct {
+	Name   string
+	Values []string
+	Unset  bool
+	Line   int
+}
+
+// SyntaxError describes a malformed CLI configuration
+type SyntaxError struct {
+	Line int
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// Attr returns the attribute of the block with the given name, or nil
+func (b *Block) Attr(name string) *Attr {
+	return findAttr(b.Attrs, name)
+}
+
+// Block returns the nested block of the block with the given path, or nil
+func (b *Block) Block(path string) *Block {
+	return findBlock(b.Blocks, path)
+}
+
+// Attr returns the attribute of the entry with the given name, or nil
+func (e *Entry) Attr(name string) *Attr {
+	return findAttr(e.Attrs, name)
+}
+
+// Block returns the nested block of the entry with the given path, or nil
+func (e *Entry) Block(path string) *Block {
+	return findBlock(e.Blocks, path)
+}
+
+// Value returns the values of the attribute joined by spaces
+func (a *Attr) Value() string {
+	return strings.Join(a.Values, " ")
+}
+
+// Block returns the top level block with the given path, or nil
+func (c *Config) Block(path string) *Block {
+	return findBlock(c.Blocks, path)
+}
+
+func findAttr(attrs []*Attr, name string) *Attr {
+	for _, a := range attrs {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+func findBlock(blocks []*Block, path string) *Block {
+	for _, b := range blocks {
+		if b.Path == path {
+			return b
+		}
+	}
+	return nil
+}
+
+// Parse reads a FortiOS CLI configuration and returns its tree.
+// It supports the config, edit, set, unset, append, delete, next and end commands,
+// nested config blocks, quoted strings and comment lines.
+func Parse(r io.Reader) (*Config, error) {
+	stmts, err := lex(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{stmts: stmts}
+	cfg := &Config{}
+
+	for p.pos < len(p.stmts) {
+		s := p.stmts[p.pos]
+		if s.words[0] != "config" {
+			return nil, &SyntaxError{Line: s.line, Msg: fmt.Sprintf("unexpected %q outside of a config block", s.words[0])}
+		}
+
+		b, err := p.block()
+		if err != nil {
+			return nil, err
+		}
+		cfg.Blocks = append(cfg.Blocks, b)
+	}
+
+	return cfg, nil
+}
+
+// statement is one command line split into words
+type statement struct {
+	line  int
+	words []string
+}
+
+// parser builds the tree from the statements
+type parser struct {
+	stmts []statement
+	pos   int
+}
+
+// block parses a config block, the current statement is its "config" command
+func (p *parser) block() (*Block, error) {
+	s := p.stmts[p.pos]
+	if len(s.words) < 2 {
+		return nil, &SyntaxError{Line: s.line, Msg: "config without a path"}
+	}
+
+	b := &Block{Path: strings.Join(s.words[1:], " "), Line: s.line}
+	p.pos++
+
+	for p.pos < len(p.stmts) {
+		s := p.stmts[p.pos]
+
+		switch s.words[0] {
+		case "end":
+			p.pos++
+			return b, nil
+		case "config":
+			nb, err := p.block()
+			if err != nil {
+				return nil, err
+			}
+			b.Blocks = append(b.Blocks, nb)
+		case "edit":
+			if len(s.words) != 2 {
+				return nil, &SyntaxError{Line: s.line, Msg: "edit needs exactly one key"}
+			}
+			e, err := p.entry()
+			if err != nil {
+				return nil, err
+			}
+			b.Entries = append(b.Entries, e)
+		case "delete":
+			if len(s.words) != 2 {
+				return nil, &SyntaxError{Line: s.line, Msg: "delete needs exactly one key"}
+			}
+			b.Deletes = append(b.Deletes, s.words[1])
+			p.pos++
+		case "set", "unset", "append":
+			a, err := attr(s)
+			if err != nil {
+				return nil, err
+			}
+			b.Attrs = mergeAttr(b.Attrs, a, s.words[0] == "append")
+			p.pos++
+		default:
+			return nil, &SyntaxError{Line: s.line, Msg: fmt.Sprintf("unexpected %q in config %s", s.words[0], b.Path)}
+		}
+	}
+
+	return nil, &SyntaxError{Line: b.Line, Msg: fmt.Sprintf("config %s is not closed by end", b.Path)}
+}
+
+// entry parses an edit entry, the current statement is its "edit" command
+func (p *parser) entry() (*Entry, error) {
+	s := p.stmts[p.pos]
+	e := &Entry{Key: s.words[1], Line: s.line}
+	p.pos++
+
+	for p.pos < len(p.stmts) {
+		s := p.stmts[p.pos]
+
+		switch s.words[0] {
+		case "next":
+			p.pos++
+			return e, nil
+		case "end":
+			// "end" closes both the entry and its table
+			return e, nil
+		case "config":
+			nb, err := p.block()
+			if err != nil {
+				return nil, err
+			}
+			e.Blocks = append(e.Blocks, nb)
+		case "set", "unset", "append":
+			a, err := attr(s)
+			if err != nil {
+				return nil, err
+			}
+			e.Attrs = mergeAttr(e.Attrs, a, s.words[0] == "append")
+			p.pos++
+		default:
+			return nil, &SyntaxError{Line: s.line, Msg: fmt.Sprintf("unexpected %q in edit %s", s.words[0], e.Key)}
+		}
+	}
+
+	return nil, &SyntaxError{Line: e.Line, Msg: fmt.Sprintf("edit %s is not closed by next", e.Key)}
+}
+
+// attr builds the attribute of a set, unset or append command
+func attr(s statement) (*Attr, error) {
+	if len(s.words) < 2 {
+		return nil, &SyntaxError{Line: s.line, Msg: s.words[0] + " without an attribute"}
+	}
+
+	a := &Attr{Name: s.words[1], Line: s.line}
+	if s.words[0] == "unset" {
+		a.Unset = true
+		return a, nil
+	}
+
+	a.Values = s.words[2:]
+	return a, nil
+}
+
+// mergeAttr adds a to attrs, replacing the previous attribute with the same name,
+// or extending it for an append command
+func mergeAttr(attrs []*Attr, a *Attr, add bool) []*Attr {
+	for i, o := range attrs {
+		if o.Name == a.Name {
+			if add && !o.Unset {
+				o.Values = append(o.Values, a.Values...)
+				return attrs
+			}
+			attrs[i] = a
+			return attrs
+		}
+	}
+	return append(attrs, a)
+}
+
+// lex splits the configuration into statements. A statement ends at the end of a line,
+// except inside a quoted string which can span several lines.
+func lex(r io.Reader) ([]statement, error) {
+	var stmts []statement
+
+	br := bufio.NewReader(r)
+	line := 1
+
+	var cur statement
+	var word strings.Builder
+	inWord := false
+	quoted := false
+	quoteLine := 0
+	comment := false
+
+	flushWord := func() {
+		if inWord {
+			cur.words = append(cur.words, word.String())
+			word.Reset()
+			inWord = false
+		}
+	}
+	flushStmt := func() {
+		flushWord()
+		if len(cur.words) > 0 {
+			stmts = append(stmts, cur)
+		}
+		cur = statement{}
+	}
+
+	for {
+		c, _, err := br.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if comment {
+			if c == '\n' {
+				comment = false
+				line++
+			}
+			continue
+		}
+
+		if quoted {
+			switch c {
+			case '\\':
+				n, _, err := br.ReadRune()
+				if err != nil {
+					return nil, &SyntaxError{Line: quoteLine, Msg: "unterminated quoted string"}
+				}
+				if n == '\n' {
+					line++
+				}
+				word.WriteRune(n)
+			case '"':
+				quoted = false
+			case '\n':
+				line++
+				word.WriteRune(c)
+			default:
+				word.WriteRune(c)
+			}
+			continue
+		}
+
+		switch {
+		case c == '\n':
+			flushStmt()
+			line++
+		case c == '\r':
+		case c == ' ' || c == '\t':
+			flushWord()
+		case c == '#' && !inWord && len(cur.words) == 0:
+			comment = true
+		case c == '"':
+			if !inWord {
+				cur.line = stmtLine(cur, line)
+			}
+			inWord = true
+			quoted = true
+			quoteLine = line
+		default:
+			if !inWord {
+				cur.line = stmtLine(cur, line)
+			}
+			inWord = true
+			word.WriteRune(c)
+		}
+	}
+
+	if quoted {
+		return nil, &SyntaxError{Line: quoteLine, Msg: "unterminated quoted string"}
+	}
+	flushStmt()
+
+	return stmts, nil
+}
+
+// stmtLine returns the line of a statement, which is the line of its first word
+func stmtLine(s statement, line int) int {
+	if len(s.words) == 0 && s.line == 0 {
+		return line
+	}
+	return s.line
+}
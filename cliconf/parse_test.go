@@ -0,0 +1,193 @@
+package cliconf
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRenderParseRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		vdom string
+		objs []interface{}
+	}{
+		{
+			name: "keyed table",
+			objs: []interface{}{
+				&testObject{Name: "web", Port: 443},
+				&testObject{Name: "db", Port: 5432, Comment: "primary"},
+			},
+		},
+		{
+			name: "sequence numbers",
+			objs: []interface{}{&testPolicy{Policyid: 7, Action: "deny"}},
+		},
+		{
+			name: "singleton",
+			vdom: "root",
+			objs: []interface{}{&testSetting{Status: "enable", Server: "192.0.2.1", Options: &testOptions{Mode: "strict", Timeout: 30}}},
+		},
+		{
+			name: "nested sub-tables and value lists",
+			objs: []interface{}{&testObject{
+				Name:   "grp",
+				Subnet: "10.0.0.0 255.255.255.0",
+				Member: []testMember{{Name: "a"}, {Name: "b c"}},
+				Rule: []testRule{
+					{ID: 3, Action: "block", Srcaddr: []testMember{{Name: "all"}, {Name: "lan"}}},
+					{ID: 5, Action: "allow"},
+				},
+			}},
+		},
+		{
+			name: "quoting and escaping",
+			objs: []interface{}{&testObject{Name: `a "b"`, Comment: "path C:\\tmp, \"quoted\"\nsecond line"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs := &ChangeSet{Vdom: tt.vdom}
+			for _, o := range tt.objs {
+				cs.Set(o, "")
+			}
+
+			got := roundTrip(t, NewRenderer(), cs)
+			if len(got) != len(tt.objs) {
+				t.Fatalf("decoded %d objects, want %d", len(got), len(tt.objs))
+			}
+			for i, o := range got {
+				if o.Vdom != tt.vdom {
+					t.Errorf("object %d vdom = %q, want %q", i, o.Vdom, tt.vdom)
+				}
+				if !reflect.DeepEqual(o.Value, tt.objs[i]) {
+					t.Errorf("object %d = %+v, want %+v", i, o.Value, tt.objs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRenderParseHiddenSecrets(t *testing.T) {
+	r := NewRenderer()
+	r.HideSecrets = true
+
+	cs := &ChangeSet{}
+	cs.Set(&testObject{Name: "srv", Port: 1812, Password: "s3cret"}, "")
+
+	got := roundTrip(t, r, cs)
+	want := &testObject{Name: "srv", Port: 1812}
+	if len(got) != 1 || !reflect.DeepEqual(got[0].Value, want) {
+		t.Errorf("decoded %+v, want %+v without the password", got, want)
+	}
+}
+
+// roundTrip renders the change set, parses the script and decodes its objects
+func roundTrip(t *testing.T, r *Renderer, cs *ChangeSet) []Object {
+	script, err := r.RenderString(cs)
+	if err != nil {
+		t.Fatalf("RenderString() error = %v", err)
+	}
+
+	cfg, err := Parse(strings.NewReader(script))
+	if err != nil {
+		t.Fatalf("Parse() error = %v\n%s", err, script)
+	}
+
+	objs, err := cfg.Objects()
+	if err != nil {
+		t.Fatalf("Objects() error = %v\n%s", err, script)
+	}
+	return objs
+}
+
+func TestParse(t *testing.T) {
+	text := `#config-version=FGT60F-7.2.5
+config test object
+    edit "web"
+        set member "a"
+        append member "b"
+        set port 80
+        set port 8080
+        set comment "multi
+line"
+    next
+    delete "old"
+end
+config test setting
+    set status enable
+    unset server
+end
+`
+
+	cfg, err := Parse(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	b := cfg.Block("test object")
+	if b == nil || len(b.Entries) != 1 {
+		t.Fatalf("config test object = %+v, want one entry", b)
+	}
+	e := b.Entries[0]
+	if e.Key != "web" || e.Line != 3 {
+		t.Errorf("entry = %s at line %d, want web at line 3", e.Key, e.Line)
+	}
+	if got := e.Attr("member").Values; !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("member = %v, want [a b]", got)
+	}
+	if got := e.Attr("port").Value(); got != "8080" {
+		t.Errorf("port = %s, want the last set 8080", got)
+	}
+	if got := e.Attr("comment").Value(); got != "multi\nline" {
+		t.Errorf("comment = %q, want the multi-line string", got)
+	}
+	if !reflect.DeepEqual(b.Deletes, []string{"old"}) {
+		t.Errorf("deletes = %v, want [old]", b.Deletes)
+	}
+
+	s := cfg.Block("test setting")
+	if s == nil || s.Attr("status").Value() != "enable" || !s.Attr("server").Unset {
+		t.Errorf("config test setting = %+v, want status set and server unset", s)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		line int
+	}{
+		{"set outside config", "set port 80\n", 1},
+		{"config without path", "config\nend\n", 1},
+		{"unclosed config", "config test object\n    edit 1\n    next\n", 1},
+		{"unclosed edit", "config test object\n    edit 1\n", 2},
+		{"edit without key", "config test object\n    edit\n    next\nend\n", 2},
+		{"unterminated string", "config test object\n    edit \"a\n", 2},
+		{"unexpected command", "config test object\n    show\nend\n", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.text))
+			se, ok := err.(*SyntaxError)
+			if !ok {
+				t.Fatalf("Parse() error = %v, want a SyntaxError", err)
+			}
+			if se.Line != tt.line {
+				t.Errorf("Parse() error at line %d, want %d: %s", se.Line, tt.line, se.Msg)
+			}
+		})
+	}
+}
+
+func TestObjectsErrors(t *testing.T) {
+	cfg, err := Parse(strings.NewReader("config test object\n    edit \"a\"\n        set port http\n    next\nend\n"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if _, err := cfg.Objects(); err == nil {
+		t.Error("Objects() error = nil, want error for an invalid number")
+	}
+}
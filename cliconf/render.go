@@ -0,0 +1,471 @@
+// Package cliconf converts the sdkcore structures to and from the FortiOS CLI configuration syntax.
+package cliconf
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Action is the operation of a Change
+type Action int
+
+const (
+	// ActionSet creates or updates the object
+	ActionSet Action = iota
+	// ActionDelete deletes the object
+	ActionDelete
+)
+
+// Change describes one object of a ChangeSet
+type Change struct {
+	Action Action
+	// Object is a sdkcore structure, such as *forticlient.JSONFirewallObjectAddress.
+	// For ActionDelete only its type is used.
+	Object interface{}
+	// Mkey is the edit key of the object. When it is empty, the key attribute of Object is used,
+	// and "0" is used for the tables keyed by a sequence number so FortiOS picks the next free one.
+	Mkey string
+}
+
+// ChangeSet is an ordered list of changes rendered as one CLI script
+type ChangeSet struct {
+	// Vdom wraps the script in "config vdom / edit <Vdom>" when it is not empty
+	Vdom    string
+	Changes []Change
+}
+
+// Set appends the creation or the update of v to the change set
+func (cs *ChangeSet) Set(v interface{}, mkey string) {
+	cs.Changes = append(cs.Changes, Change{Action: ActionSet, Object: v, Mkey: mkey})
+}
+
+// Delete appends the deletion of the object of type v with the given key to the change set
+func (cs *ChangeSet) Delete(v interface{}, mkey string) {
+	cs.Changes = append(cs.Changes, Change{Action: ActionDelete, Object: v, Mkey: mkey})
+}
+
+// Renderer writes sdkcore structures as FortiOS CLI configuration
+type Renderer struct {
+	// Indent is the indentation of one nesting level
+	Indent string
+	// HideSecrets replaces passwords and pre-shared keys by a comment
+	HideSecrets bool
+}
+
+// NewRenderer creates a Renderer using the indentation of "show full-configuration"
+func NewRenderer() *Renderer {
+	return &Renderer{
+		Indent: "    ",
+	}
+}
+
+// RenderString returns the CLI script of the change set
+func (r *Renderer) RenderString(cs *ChangeSet) (string, error) {
+	var b bytes.Buffer
+
+	if err := r.Render(&b, cs); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// Render writes the CLI script of the change set to w.
+// Consecutive changes on the same table share a single config block.
+func (r *Renderer) Render(w io.Writer, cs *ChangeSet) error {
+	p := &printer{w: w, indent: r.Indent, hideSecrets: r.HideSecrets}
+
+	if cs.Vdom != "" {
+		p.line("config vdom")
+		p.line("edit " + quote(cs.Vdom))
+		p.depth++
+	}
+
+	var cur *Table
+	for i, c := range cs.Changes {
+		t, ok := Lookup(c.Object)
+		if !ok {
+			return fmt.Errorf("change %d: no CLI table for %T", i, c.Object)
+		}
+
+		if cur != nil && (cur.Path != t.Path || t.Singleton) {
+			p.depth--
+			p.line("end")
+			cur = nil
+		}
+
+		if cur == nil {
+			p.line("config " + t.Path)
+			p.depth++
+			cur = &t
+		}
+
+		if err := p.change(t, c); err != nil {
+			return fmt.Errorf("change %d: %s", i, err)
+		}
+	}
+
+	if cur != nil {
+		p.depth--
+		p.line("end")
+	}
+
+	if cs.Vdom != "" {
+		p.depth--
+		p.line("next")
+		p.line("end")
+	}
+
+	return p.err
+}
+
+// printer keeps the state of a rendering
+type printer struct {
+	w           io.Writer
+	indent      string
+	hideSecrets bool
+	depth       int
+	err         error
+}
+
+// line writes one indented line
+func (p *printer) line(s string) {
+	if p.err != nil {
+		return
+	}
+	_, p.err = io.WriteString(p.w, strings.Repeat(p.indent, p.depth)+s+"\n")
+}
+
+// change writes the body of a change inside its config block
+func (p *printer) change(t Table, c Change) error {
+	v := reflect.ValueOf(c.Object)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return fmt.Errorf("nil %s", v.Type())
+		}
+		v = v.Elem()
+	}
+
+	if t.Singleton {
+		if c.Action == ActionDelete {
+			return fmt.Errorf("%s cannot be deleted", t.Path)
+		}
+		p.attributes(v, "")
+		return nil
+	}
+
+	key := c.Mkey
+	if key == "" && c.Action == ActionSet {
+		if kv, ok := attribute(v, t.Key); ok {
+			key = scalar(kv)
+		}
+	}
+
+	numeric := t.Key != "name"
+	if key == "" {
+		if !numeric {
+			return fmt.Errorf("no %s for the %s entry", t.Key, t.Path)
+		}
+		key = "0"
+	}
+
+	if c.Action == ActionDelete {
+		p.line("delete " + editKey(key, numeric))
+		return nil
+	}
+
+	p.line("edit " + editKey(key, numeric))
+	p.depth++
+	p.attributes(v, t.Key)
+	p.depth--
+	p.line("next")
+
+	return nil
+}
+
+// attributes writes the attributes of the structure v, skipping the skip attribute
+func (p *printer) attributes(v reflect.Value, skip string) {
+	for _, f := range fields(v) {
+		if f.name == skip {
+			continue
+		}
+		p.attribute(f.name, f.value)
+	}
+}
+
+// attribute writes one attribute as a set line or a nested config block
+func (p *printer) attribute(name string, v reflect.Value) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		if _, ok := v.Interface().(encoding.TextMarshaler); ok {
+			break
+		}
+		v = v.Elem()
+	}
+
+	if _, ok := v.Interface().(encoding.TextMarshaler); !ok {
+		switch v.Kind() {
+		case reflect.Struct:
+			p.line("config " + name)
+			p.depth++
+			p.attributes(v, "")
+			p.depth--
+			p.line("end")
+			return
+		case reflect.Slice, reflect.Array:
+			p.list(name, v)
+			return
+		}
+	}
+
+	if isZero(v) {
+		return
+	}
+
+	if p.hideSecrets && secretFields[name] {
+		p.line("# set " + name + " <hidden>")
+		return
+	}
+
+	s := scalar(v)
+	if tokenFields[name] {
+		toks := strings.Fields(s)
+		for i := range toks {
+			toks[i] = token(toks[i])
+		}
+		p.line("set " + name + " " + strings.Join(toks, " "))
+		return
+	}
+
+	p.line("set " + name + " " + token(s))
+}
+
+// list writes a slice as a value list or as a sub-table
+func (p *printer) list(name string, v reflect.Value) {
+	if v.Len() == 0 {
+		return
+	}
+
+	et := v.Type().Elem()
+	for et.Kind() == reflect.Ptr {
+		et = et.Elem()
+	}
+
+	if et.Kind() != reflect.Struct || isTextMarshaler(et) {
+		vals := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			vals = append(vals, token(scalar(v.Index(i))))
+		}
+		p.line("set " + name + " " + strings.Join(vals, " "))
+		return
+	}
+
+	if et.NumField() == 1 {
+		if fn := jsonName(et.Field(0)); listFields[fn] {
+			vals := make([]string, 0, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				e := reflect.Indirect(v.Index(i)).Field(0)
+				if e.Kind() == reflect.String {
+					vals = append(vals, quote(e.String()))
+				} else {
+					vals = append(vals, scalar(e))
+				}
+			}
+			p.line("set " + name + " " + strings.Join(vals, " "))
+			return
+		}
+	}
+
+	p.line("config " + name)
+	p.depth++
+	for i := 0; i < v.Len(); i++ {
+		e := reflect.Indirect(v.Index(i))
+		if !e.IsValid() {
+			continue
+		}
+
+		skip := ""
+		key := strconv.Itoa(i + 1)
+		numeric := true
+		if kv, ok := attribute(e, "id"); ok && !isZero(kv) {
+			skip, key = "id", scalar(kv)
+		} else if kv, ok := attribute(e, "name"); ok && !isZero(kv) {
+			skip, key, numeric = "name", scalar(kv), false
+		}
+
+		p.line("edit " + editKey(key, numeric))
+		p.depth++
+		p.attributes(e, skip)
+		p.depth--
+		p.line("next")
+	}
+	p.depth--
+	p.line("end")
+}
+
+// field is an attribute of a structure with its CLI name
+type field struct {
+	name  string
+	value reflect.Value
+}
+
+// fields returns the attributes of the structure v in declaration order,
+// the attributes of the embedded structures are inlined
+func fields(v reflect.Value) []field {
+	var fs []field
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		fv := v.Field(i)
+
+		if sf.Anonymous {
+			for fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					break
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				fs = append(fs, fields(fv)...)
+			}
+			continue
+		}
+
+		if sf.PkgPath != "" {
+			continue
+		}
+
+		name := jsonName(sf)
+		if name == "" {
+			continue
+		}
+
+		fs = append(fs, field{name: name, value: fv})
+	}
+
+	return fs
+}
+
+// attribute returns the attribute of the structure v with the given CLI name
+func attribute(v reflect.Value, name string) (reflect.Value, bool) {
+	if name == "" {
+		return reflect.Value{}, false
+	}
+
+	for _, f := range fields(v) {
+		if f.name == name {
+			return reflect.Indirect(f.value), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// jsonName returns the attribute name from the json tag of the structure field
+func jsonName(sf reflect.StructField) string {
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if i := strings.Index(tag, ","); i >= 0 {
+		tag = tag[:i]
+	}
+	return tag
+}
+
+// isZero reports whether v holds the zero value of its type
+func isZero(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+	return v.IsZero()
+}
+
+// isTextMarshaler reports whether t or *t implements encoding.TextMarshaler
+func isTextMarshaler(t reflect.Type) bool {
+	tm := reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	return t.Implements(tm) || reflect.PtrTo(t).Implements(tm)
+}
+
+// scalar formats a single value
+func scalar(v reflect.Value) string {
+	if v.CanInterface() {
+		if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+			b, err := m.MarshalText()
+			if err == nil {
+				return string(b)
+			}
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return ""
+		}
+		return scalar(v.Elem())
+	case reflect.String:
+		return v.String()
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		if v.Bool() {
+			return "enable"
+		}
+		return "disable"
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+// editKey formats the key of an edit or delete command
+func editKey(key string, numeric bool) string {
+	if numeric {
+		if _, err := strconv.ParseUint(key, 10, 64); err == nil {
+			return key
+		}
+	}
+	return quote(key)
+}
+
+// token returns s unquoted when it is a single safe word, and quoted otherwise
+func token(s string) string {
+	if s == "" {
+		return `""`
+	}
+
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '.', r == ':', r == '/', r == '_', r == '-':
+		default:
+			return quote(s)
+		}
+	}
+
+	return s
+}
+
+// quote returns s between double quotes, escaping the quotes and backslashes
+func quote(s string) string {
+	var b strings.Builder
+
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
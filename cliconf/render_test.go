@@ -0,0 +1,235 @@
+package cliconf
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testMember is a single attribute member, rendered as a value list
+type testMember struct {
+	Name string `json:"name"`
+}
+
+// testRule is an entry of a sub-table
+type testRule struct {
+	ID      int          `json:"id"`
+	Action  string       `json:"action"`
+	Srcaddr []testMember `json:"srcaddr"`
+}
+
+// testOptions is a nested structure, rendered as a config block without entries
+type testOptions struct {
+	Mode    string `json:"mode"`
+	Timeout int    `json:"timeout"`
+}
+
+// testObject is an entry of a table keyed by name
+type testObject struct {
+	Name     string       `json:"name"`
+	Comment  string       `json:"comment"`
+	Port     int          `json:"port"`
+	Subnet   string       `json:"subnet"`
+	Member   []testMember `json:"member"`
+	Rule     []testRule   `json:"rule"`
+	Password string       `json:"password"`
+	Internal string       `json:"-"`
+}
+
+// testPolicy is an entry of a table keyed by a sequence number
+type testPolicy struct {
+	Policyid  int    `json:"policyid"`
+	Action    string `json:"action"`
+	Psksecret string `json:"psksecret"`
+}
+
+// testSetting is a singleton table
+type testSetting struct {
+	Status  string       `json:"status"`
+	Server  string       `json:"server"`
+	Options *testOptions `json:"options"`
+}
+
+func init() {
+	Register(testObject{}, Table{Path: "test object", Key: "name"})
+	Register(testPolicy{}, Table{Path: "test policy", Key: "policyid"})
+	Register(testSetting{}, Table{Path: "test setting", Singleton: true})
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		cs   ChangeSet
+		hide bool
+		want string
+	}{
+		{
+			name: "keyed table",
+			cs: ChangeSet{Changes: []Change{
+				{Object: &testObject{Name: "web", Port: 443, Internal: "skipped"}},
+				{Object: &testObject{Name: "db", Port: 5432}},
+				{Action: ActionDelete, Object: &testObject{}, Mkey: "old"},
+			}},
+			want: "config test object\n" +
+				"    edit \"web\"\n" +
+				"        set port 443\n" +
+				"    next\n" +
+				"    edit \"db\"\n" +
+				"        set port 5432\n" +
+				"    next\n" +
+				"    delete \"old\"\n" +
+				"end\n",
+		},
+		{
+			name: "sequence numbers",
+			cs: ChangeSet{Changes: []Change{
+				{Object: &testPolicy{Action: "accept"}},
+				{Object: &testPolicy{Policyid: 7, Action: "deny"}},
+			}},
+			want: "config test policy\n" +
+				"    edit 0\n" +
+				"        set action accept\n" +
+				"    next\n" +
+				"    edit 7\n" +
+				"        set action deny\n" +
+				"    next\n" +
+				"end\n",
+		},
+		{
+			name: "singleton with nested structure",
+			cs: ChangeSet{Vdom: "root", Changes: []Change{
+				{Object: &testSetting{Status: "enable", Options: &testOptions{Mode: "strict", Timeout: 30}}},
+			}},
+			want: "config vdom\n" +
+				"edit \"root\"\n" +
+				"    config test setting\n" +
+				"        set status enable\n" +
+				"        config options\n" +
+				"            set mode strict\n" +
+				"            set timeout 30\n" +
+				"        end\n" +
+				"    end\n" +
+				"next\n" +
+				"end\n",
+		},
+		{
+			name: "value lists and sub-tables",
+			cs: ChangeSet{Changes: []Change{
+				{Object: &testObject{
+					Name:   "grp",
+					Subnet: "10.0.0.0 255.255.255.0",
+					Member: []testMember{{Name: "a"}, {Name: "b c"}},
+					Rule: []testRule{
+						{ID: 3, Action: "block", Srcaddr: []testMember{{Name: "all"}}},
+						{Action: "allow"},
+					},
+				}},
+			}},
+			want: "config test object\n" +
+				"    edit \"grp\"\n" +
+				"        set subnet 10.0.0.0 255.255.255.0\n" +
+				"        set member \"a\" \"b c\"\n" +
+				"        config rule\n" +
+				"            edit 3\n" +
+				"                set action block\n" +
+				"                set srcaddr \"all\"\n" +
+				"            next\n" +
+				"            edit 2\n" +
+				"                set action allow\n" +
+				"            next\n" +
+				"        end\n" +
+				"    next\n" +
+				"end\n",
+		},
+		{
+			name: "quoting and escaping",
+			cs: ChangeSet{Changes: []Change{
+				{Object: &testObject{Name: `a "b"`, Comment: `path C:\tmp, "quoted"`}},
+			}},
+			want: "config test object\n" +
+				"    edit \"a \\\"b\\\"\"\n" +
+				"        set comment \"path C:\\\\tmp, \\\"quoted\\\"\"\n" +
+				"    next\n" +
+				"end\n",
+		},
+		{
+			name: "secrets shown",
+			cs: ChangeSet{Changes: []Change{
+				{Object: &testObject{Name: "srv", Password: "s3cret"}},
+			}},
+			want: "config test object\n" +
+				"    edit \"srv\"\n" +
+				"        set password s3cret\n" +
+				"    next\n" +
+				"end\n",
+		},
+		{
+			name: "secrets hidden",
+			cs: ChangeSet{Changes: []Change{
+				{Object: &testObject{Name: "srv", Password: "s3cret"}},
+				{Object: &testPolicy{Policyid: 1, Psksecret: "key"}},
+			}},
+			hide: true,
+			want: "config test object\n" +
+				"    edit \"srv\"\n" +
+				"        # set password <hidden>\n" +
+				"    next\n" +
+				"end\n" +
+				"config test policy\n" +
+				"    edit 1\n" +
+				"        # set psksecret <hidden>\n" +
+				"    next\n" +
+				"end\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRenderer()
+			r.HideSecrets = tt.hide
+
+			got, err := r.RenderString(&tt.cs)
+			if err != nil {
+				t.Fatalf("RenderString() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RenderString() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		cs   ChangeSet
+	}{
+		{"unknown type", ChangeSet{Changes: []Change{{Object: &testMember{Name: "a"}}}}},
+		{"missing name", ChangeSet{Changes: []Change{{Object: &testObject{Port: 1}}}}},
+		{"nil object", ChangeSet{Changes: []Change{{Object: (*testObject)(nil)}}}},
+		{"singleton delete", ChangeSet{Changes: []Change{{Action: ActionDelete, Object: &testSetting{}}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRenderer().RenderString(&tt.cs); err == nil {
+				t.Error("RenderString() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestSecretFields(t *testing.T) {
+	for _, name := range []string{
+		"password", "passwd", "psksecret", "secret", "authentication-key",
+		"secondary-secret", "tertiary-secret", "key", "secondary-key", "tertiary-key",
+	} {
+		var b strings.Builder
+		p := &printer{w: &b, indent: "    ", hideSecrets: true}
+		p.attribute(name, reflect.ValueOf("s3cret"))
+
+		if got := b.String(); got != "# set "+name+" <hidden>\n" {
+			t.Errorf("%s is not hidden by HideSecrets, got %q", name, got)
+		}
+	}
+}
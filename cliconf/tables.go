@@ -0,0 +1,170 @@
+package cliconf
+
+import (
+	"reflect"
+	"sync"
+
+	forticlient "github.com/fgtdev/fortios-sdk-go/sdkcore"
+)
+
+// Table describes where a sdkcore structure lives in the FortiOS CLI configuration
+type Table struct {
+	// Path is the CLI path of the table, such as "firewall address"
+	Path string
+	// Key is the attribute used as the edit key, such as "name" or "policyid"
+	Key string
+	// Singleton is true for the tables without edit entries, such as "system global"
+	Singleton bool
+}
+
+var tablesMu sync.RWMutex
+
+// tables maps the sdkcore structures to their CLI table
+var tables = map[reflect.Type]Table{
+	typeOf(forticlient.JSONAntivirusProfile{}):               {Path: "antivirus profile", Key: "name"},
+	typeOf(forticlient.JSONApplicationList{}):                {Path: "application list", Key: "name"},
+	typeOf(forticlient.JSONDnsfilterDomainFilter{}):          {Path: "dnsfilter domain-filter", Key: "id"},
+	typeOf(forticlient.JSONDnsfilterProfile{}):               {Path: "dnsfilter profile", Key: "name"},
+	typeOf(forticlient.JSONFirewallCentralSnatMap{}):         {Path: "firewall central-snat-map", Key: "policyid"},
+	typeOf(forticlient.JSONFirewallDoSPolicy{}):              {Path: "firewall DoS-policy", Key: "policyid"},
+	typeOf(forticlient.JSONFirewallInternetServiceCustom{}):  {Path: "firewall internet-service-custom", Key: "name"},
+	typeOf(forticlient.JSONFirewallInternetServiceGroup{}):   {Path: "firewall internet-service-group", Key: "name"},
+	typeOf(forticlient.JSONFirewallLocalInPolicy{}):          {Path: "firewall local-in-policy", Key: "policyid"},
+	typeOf(forticlient.JSONFirewallLocalInPolicy6{}):         {Path: "firewall local-in-policy6", Key: "policyid"},
+	typeOf(forticlient.JSONFirewallObjectAddress{}):          {Path: "firewall address", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectAddress6{}):         {Path: "firewall address6", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectAddressGroup{}):     {Path: "firewall addrgrp", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectAddressGroup6{}):    {Path: "firewall addrgrp6", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectIPPool{}):           {Path: "firewall ippool", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectIPPool6{}):          {Path: "firewall ippool6", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectLdbMonitor{}):       {Path: "firewall ldb-monitor", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectService{}):          {Path: "firewall service custom", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectServiceCategory{}):  {Path: "firewall service category", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectServiceGroup{}):     {Path: "firewall service group", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectVip{}):              {Path: "firewall vip", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectVip46{}):            {Path: "firewall vip46", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectVip6{}):             {Path: "firewall vip6", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectVip64{}):            {Path: "firewall vip64", Key: "name"},
+	typeOf(forticlient.JSONFirewallObjectVipGroup{}):         {Path: "firewall vipgrp", Key: "name"},
+	typeOf(forticlient.JSONFirewallProfileProtocolOptions{}): {Path: "firewall profile-protocol-options", Key: "name"},
+	typeOf(forticlient.JSONFirewallScheduleGroup{}):          {Path: "firewall schedule group", Key: "name"},
+	typeOf(forticlient.JSONFirewallScheduleOnetime{}):        {Path: "firewall schedule onetime", Key: "name"},
+	typeOf(forticlient.JSONFirewallScheduleRecurring{}):      {Path: "firewall schedule recurring", Key: "name"},
+	typeOf(forticlient.JSONFirewallSecurityPolicy{}):         {Path: "firewall policy", Key: "policyid"},
+	typeOf(forticlient.JSONFirewallShaperPerIPShaper{}):      {Path: "firewall shaper per-ip-shaper", Key: "name"},
+	typeOf(forticlient.JSONFirewallShaperTrafficShaper{}):    {Path: "firewall shaper traffic-shaper", Key: "name"},
+	typeOf(forticlient.JSONFirewallShapingPolicy{}):          {Path: "firewall shaping-policy", Key: "id"},
+	typeOf(forticlient.JSONFirewallShapingProfile{}):         {Path: "firewall shaping-profile", Key: "profile-name"},
+	typeOf(forticlient.JSONFirewallSslSSHProfile{}):          {Path: "firewall ssl-ssh-profile", Key: "name"},
+	typeOf(forticlient.JSONIpsSensor{}):                      {Path: "ips sensor", Key: "name"},
+	typeOf(forticlient.JSONLogFortiAnalyzerSetting{}):        {Path: "log fortianalyzer setting", Singleton: true},
+	typeOf(forticlient.JSONLogSyslogSetting{}):               {Path: "log syslogd setting", Singleton: true},
+	typeOf(forticlient.JSONNetworkingInterfacePort{}):        {Path: "system interface", Key: "name"},
+	typeOf(forticlient.JSONNetworkingRouteStatic{}):          {Path: "router static", Key: "seq-num"},
+	typeOf(forticlient.JSONNetworkingRouteStatic6{}):         {Path: "router static6", Key: "seq-num"},
+	typeOf(forticlient.JSONRouterAccessList{}):               {Path: "router access-list", Key: "name"},
+	typeOf(forticlient.JSONRouterOspf{}):                     {Path: "router ospf", Singleton: true},
+	typeOf(forticlient.JSONRouterPolicy{}):                   {Path: "router policy", Key: "seq-num"},
+	typeOf(forticlient.JSONRouterPrefixList{}):               {Path: "router prefix-list", Key: "name"},
+	typeOf(forticlient.JSONRouterRouteMap{}):                 {Path: "router route-map", Key: "name"},
+	typeOf(forticlient.JSONSystemAdminAdministrator{}):       {Path: "system admin", Key: "name"},
+	typeOf(forticlient.JSONSystemAdminAdministrator2{}):      {Path: "system admin", Key: "name"},
+	typeOf(forticlient.JSONSystemAdminProfiles{}):            {Path: "system accprofile", Key: "name"},
+	typeOf(forticlient.JSONSystemAPIUserSetting{}):           {Path: "system api-user", Key: "name"},
+	typeOf(forticlient.JSONSystemExternalResource{}):         {Path: "system external-resource", Key: "name"},
+	typeOf(forticlient.JSONSystemSettingDNS{}):               {Path: "system dns", Singleton: true},
+	typeOf(forticlient.JSONSystemSettingGlobal{}):            {Path: "system global", Singleton: true},
+	typeOf(forticlient.JSONSystemSettingNTP{}):               {Path: "system ntp", Singleton: true},
+	typeOf(forticlient.JSONSystemVdomSetting{}):              {Path: "system vdom", Key: "name"},
+	typeOf(forticlient.JSONUserGroup{}):                      {Path: "user group", Key: "name"},
+	typeOf(forticlient.JSONUserLdap{}):                       {Path: "user ldap", Key: "name"},
+	typeOf(forticlient.JSONUserLocal{}):                      {Path: "user local", Key: "name"},
+	typeOf(forticlient.JSONUserRadius{}):                     {Path: "user radius", Key: "name"},
+	typeOf(forticlient.JSONUserSaml{}):                       {Path: "user saml", Key: "name"},
+	typeOf(forticlient.JSONUserTacacs{}):                     {Path: "user tacacs+", Key: "name"},
+	typeOf(forticlient.JSONVPNIPsecPhase1Interface{}):        {Path: "vpn ipsec phase1-interface", Key: "name"},
+	typeOf(forticlient.JSONVPNIPsecPhase2Interface{}):        {Path: "vpn ipsec phase2-interface", Key: "name"},
+	typeOf(forticlient.JSONWebfilterFtgdLocalCat{}):          {Path: "webfilter ftgd-local-cat", Key: "desc"},
+	typeOf(forticlient.JSONWebfilterFtgdLocalRating{}):       {Path: "webfilter ftgd-local-rating", Key: "url"},
+	typeOf(forticlient.JSONWebfilterProfile{}):               {Path: "webfilter profile", Key: "name"},
+	typeOf(forticlient.JSONWebfilterURLFilter{}):             {Path: "webfilter urlfilter", Key: "id"},
+}
+
+// tokenFields are the attributes FortiOS shows as a list of unquoted tokens,
+// such as "set subnet 10.0.0.0 255.255.255.0" or "set allowaccess ping https"
+var tokenFields = map[string]bool{
+	"allowaccess":    true,
+	"day":            true,
+	"dst":            true,
+	"dst-subnet":     true,
+	"ip":             true,
+	"ipv4-trusthost": true,
+	"prefix":         true,
+	"proposal":       true,
+	"sctp-portrange": true,
+	"src-subnet":     true,
+	"subnet":         true,
+	"tcp-portrange":  true,
+	"trusthost1":     true,
+	"trusthost2":     true,
+	"trusthost3":     true,
+	"trusthost4":     true,
+	"trusthost5":     true,
+	"trusthost6":     true,
+	"trusthost7":     true,
+	"trusthost8":     true,
+	"trusthost9":     true,
+	"trusthost10":    true,
+	"udp-portrange":  true,
+	"wildcard":       true,
+}
+
+// secretFields are the attributes hidden by Renderer.HideSecrets
+var secretFields = map[string]bool{
+	"authentication-key": true,
+	"key":                true,
+	"passwd":             true,
+	"password":           true,
+	"psksecret":          true,
+	"secondary-key":      true,
+	"secondary-secret":   true,
+	"secret":             true,
+	"tertiary-key":       true,
+	"tertiary-secret":    true,
+}
+
+// listFields are the attributes of the single attribute structures which FortiOS
+// shows as a value list, such as "set srcaddr "a" "b"", instead of a sub-table
+var listFields = map[string]bool{
+	"id":     true,
+	"name":   true,
+	"range":  true,
+	"subnet": true,
+}
+
+// Register associates the type of v with the CLI table t.
+// It lets the renderer and the parser handle structures defined outside of sdkcore.
+func Register(v interface{}, t Table) {
+	tablesMu.Lock()
+	defer tablesMu.Unlock()
+
+	tables[typeOf(v)] = t
+}
+
+// Lookup returns the CLI table of the type of v
+func Lookup(v interface{}) (Table, bool) {
+	tablesMu.RLock()
+	defer tablesMu.RUnlock()
+
+	t, ok := tables[typeOf(v)]
+	return t, ok
+}
+
+// typeOf returns the structure type of v, v can be a structure or a pointer to a structure
+func typeOf(v interface{}) reflect.Type {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	forticlient "github.com/fgtdev/fortios-sdk-go/sdkcore"
+)
+
+// backupTimeFormat is the timestamp format used in backup file names,
+// it sorts in chronological order
+const backupTimeFormat = "20060102-150405"
+
+// backupTimeGlob matches the timestamps written with backupTimeFormat
+const backupTimeGlob = "[0-9][0-9][0-9][0-9][0-9][0-9][0-9][0-9]-[0-9][0-9][0-9][0-9][0-9][0-9]"
+
+// runBackup implements the backup command
+func runBackup(args []string) error {
+	fs := flag.NewFlagSet("backup", flag.ExitOnError)
+	dir := fs.String("dir", ".", "directory to store the backup files in")
+	scope := fs.String("scope", "global", "backup scope, global or vdom")
+	vdom := fs.String("vdom", "", "vdom to back up with the vdom scope")
+	password := fs.String("password", "", "password to encrypt the backup files with")
+	interval := fs.Duration("interval", 0, "interval between two backups, 0 runs a single backup")
+	keep := fs.Int("keep", 0, "number of backup files to keep, 0 keeps all of them")
+	fs.Parse(args)
+
+	if *scope == "vdom" && *vdom == "" {
+		return fmt.Errorf("-vdom is required with the vdom scope")
+	}
+	if *keep < 0 {
+		return fmt.Errorf("-keep must not be negative")
+	}
+
+	c, err := newClient("")
+	if err != nil {
+		return err
+	}
+
+	params := &forticlient.JSONSystemConfigBackup{
+		Scope:    *scope,
+		Vdom:     *vdom,
+		Password: *password,
+	}
+
+	prefix := backupPrefix(c.Config.FwTarget, params)
+
+	for {
+		file, err := backupOnce(c, params, *dir, prefix)
+		if err != nil {
+			if *interval == 0 {
+				return err
+			}
+			log.Printf("backup failed: %s", err)
+		} else {
+			log.Printf("backup saved to %s", file)
+
+			if *keep > 0 {
+				if err := rotateBackups(*dir, prefix, *keep); err != nil {
+					log.Printf("rotation failed: %s", err)
+				}
+			}
+		}
+
+		if *interval == 0 {
+			return nil
+		}
+		time.Sleep(*interval)
+	}
+}
+
+// backupPrefix returns the file name prefix shared by all the backups of a device and scope
+func backupPrefix(host string, params *forticlient.JSONSystemConfigBackup) string {
+	name := strings.NewReplacer(":", "_", "/", "_").Replace(host)
+	if params.Scope == "vdom" {
+		return name + "-vdom-" + params.Vdom + "-"
+	}
+	return name + "-global-"
+}
+
+// backupOnce writes a new backup file and returns its path.
+// The file is written under a temporary name first so an interrupted backup
+// never looks like a complete one.
+func backupOnce(c *forticlient.FortiSDKClient, params *forticlient.JSONSystemConfigBackup, dir string, prefix string) (string, error) {
+	name := filepath.Join(dir, prefix+time.Now().Format(backupTimeFormat)+".conf")
+
+	f, err := ioutil.TempFile(dir, ".fortiosctl-backup-")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.BackupConfig(params, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	if err := os.Rename(f.Name(), name); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return name, nil
+}
+
+// rotateBackups removes the oldest backup files with the given prefix so that keep files remain
+func rotateBackups(dir string, prefix string, keep int) error {
+	files, err := filepath.Glob(filepath.Join(dir, prefix+backupTimeGlob+".conf"))
+	if err != nil {
+		return err
+	}
+
+	if len(files) <= keep {
+		return nil
+	}
+
+	sort.Strings(files)
+
+	for _, f := range files[:len(files)-keep] {
+		if err := os.Remove(f); err != nil {
+			return err
+		}
+		log.Printf("backup %s removed", f)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+	forticlient "github.com/fgtdev/fortios-sdk-go/sdkcore"
+)
+
+// newClient creates the FortiOS client from the environment
+func newClient(vdom string) (*forticlient.FortiSDKClient, error) {
+	a := auth.NewAuth("", "", "", vdom)
+
+	if _, err := a.GetEnvHostname(); err != nil {
+		return nil, fmt.Errorf("FORTIOS_ACCESS_HOSTNAME is not set")
+	}
+	if _, err := a.GetEnvToken(); err != nil {
+		return nil, fmt.Errorf("FORTIOS_ACCESS_TOKEN is not set")
+	}
+	if _, err := a.GetEnvCABundle(); err != nil {
+		return nil, err
+	}
+	insecure, _ := a.GetEnvInsecure()
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: insecure,
+	}
+
+	if a.CABundle != "" {
+		pem, err := ioutil.ReadFile(a.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read CA bundle %s", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("cannot load CA bundle %s", a.CABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: tlsConfig,
+		},
+	}
+
+	return forticlient.NewClient(a, client), nil
+}
@@ -0,0 +1,46 @@
+// Command fortiosctl runs maintenance tasks against FortiOS through the SDK.
+//
+// The device and the API token are taken from the FORTIOS_ACCESS_HOSTNAME,
+// FORTIOS_ACCESS_TOKEN, FORTIOS_CA_CABUNDLE and FORTIOS_INSECURE environment variables.
+//
+// Usage:
+//
+//	fortiosctl backup [flags]
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: fortiosctl <command> [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "commands:\n")
+	fmt.Fprintf(os.Stderr, "  backup    back up the configuration, once or on a schedule with rotation\n")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+
+	switch os.Args[1] {
+	case "backup":
+		err = runBackup(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage()
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fortiosctl %s: %s\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
@@ -14,4 +14,6 @@ type Config struct {
 	Auth     *auth.Auth
 	HTTPCon  *http.Client
 	FwTarget string
+	// TransactionID is the cmdb transaction the requests belong to, 0 when there is none
+	TransactionID int
 }
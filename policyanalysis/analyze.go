@@ -0,0 +1,225 @@
+package policyanalysis
+
+import (
+	"fmt"
+)
+
+// FindingKind is the kind of problem reported by Analyze
+type FindingKind string
+
+const (
+	// Shadowed is a policy never matched because an earlier policy with a different
+	// action or different settings matches all its traffic
+	Shadowed FindingKind = "shadowed"
+	// Redundant is a policy which can be removed without changing what happens to the traffic,
+	// because another policy with the same settings handles all its traffic
+	Redundant FindingKind = "redundant"
+	// Mergeable is a pair of policies with the same settings which differ in a single
+	// attribute and can be replaced by one policy
+	Mergeable FindingKind = "mergeable"
+)
+
+// Finding is a problem found by Analyze
+type Finding struct {
+	Kind FindingKind
+	// PolicyID is the policy the finding is about
+	PolicyID int
+	// OtherID is the policy which shadows PolicyID, makes it redundant, or can be merged with it
+	OtherID int
+	Reason  string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("policy %d %s by policy %d: %s", f.PolicyID, f.Kind, f.OtherID, f.Reason)
+}
+
+// Analyze checks the ordered list of policies and reports the shadowed, redundant and
+// mergeable policies. The disabled policies are ignored, and so are the policies matching
+// on criteria the analysis does not model, such as internet services or devices.
+// The objects which are not found in objs, or cannot be resolved to addresses or ports,
+// such as FQDN addresses, only match themselves.
+// Returns error when an object cannot be parsed.
+func Analyze(policies []Policy, objs *Objects) ([]Finding, error) {
+	if objs == nil {
+		objs = NewObjects()
+	}
+
+	var rs []*resolved
+	for _, p := range policies {
+		if p.JSONFirewallSecurityPolicy != nil && p.Status == "disable" {
+			continue
+		}
+
+		r, err := objs.resolve(p)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+
+	findings := []Finding{}
+	done := make(map[int]bool)
+
+	// a policy covered by an earlier one is never matched
+	for j, b := range rs {
+		if b.opaque {
+			continue
+		}
+		for _, a := range rs[:j] {
+			if a.opaque || !covers(a, b) {
+				continue
+			}
+
+			f := Finding{PolicyID: b.policy.ID, OtherID: a.policy.ID}
+			if a.settings == b.settings {
+				f.Kind = Redundant
+				f.Reason = fmt.Sprintf("earlier policy %d matches all its traffic with the same settings", a.policy.ID)
+			} else if a.policy.Action != b.policy.Action {
+				f.Kind = Shadowed
+				f.Reason = fmt.Sprintf("earlier policy %d matches all its traffic with action %s instead of %s",
+					a.policy.ID, a.policy.Action, b.policy.Action)
+			} else {
+				f.Kind = Shadowed
+				f.Reason = fmt.Sprintf("earlier policy %d matches all its traffic with different settings", a.policy.ID)
+			}
+
+			findings = append(findings, f)
+			done[b.policy.ID] = true
+			break
+		}
+	}
+
+	// a policy covered by a later one with the same settings can be removed,
+	// as long as no policy in between matches part of its traffic
+	for i, a := range rs {
+		if a.opaque || done[a.policy.ID] {
+			continue
+		}
+		for j := i + 1; j < len(rs); j++ {
+			c := rs[j]
+			if c.opaque {
+				break
+			}
+
+			if c.settings == a.settings && covers(c, a) {
+				findings = append(findings, Finding{
+					Kind:     Redundant,
+					PolicyID: a.policy.ID,
+					OtherID:  c.policy.ID,
+					Reason:   fmt.Sprintf("later policy %d matches all its traffic with the same settings", c.policy.ID),
+				})
+				done[a.policy.ID] = true
+				break
+			}
+
+			if c.settings != a.settings && mayIntersect(a, c) {
+				break
+			}
+		}
+	}
+
+	// two policies with the same settings differing in one attribute can be merged,
+	// as long as no policy in between matches part of the traffic of the later one
+	for i, a := range rs {
+		if a.opaque || done[a.policy.ID] {
+			continue
+		}
+		for j := i + 1; j < len(rs); j++ {
+			b := rs[j]
+			if b.opaque || done[b.policy.ID] || a.settings != b.settings {
+				continue
+			}
+
+			attr, ok := mergeable(a, b)
+			if !ok {
+				continue
+			}
+
+			between := false
+			for _, k := range rs[i+1 : j] {
+				if mayIntersect(k, b) {
+					between = true
+					break
+				}
+			}
+			if between {
+				continue
+			}
+
+			findings = append(findings, Finding{
+				Kind:     Mergeable,
+				PolicyID: b.policy.ID,
+				OtherID:  a.policy.ID,
+				Reason:   fmt.Sprintf("same settings and same match criteria except %s", attr),
+			})
+			done[b.policy.ID] = true
+			break
+		}
+	}
+
+	return findings, nil
+}
+
+// covers reports whether a matches all the traffic of b
+func covers(a *resolved, b *resolved) bool {
+	return b.srcintf.subsetOf(a.srcintf) &&
+		b.dstintf.subsetOf(a.dstintf) &&
+		b.srcaddr.subsetOf(a.srcaddr) &&
+		b.dstaddr.subsetOf(a.dstaddr) &&
+		b.service.subsetOf(a.service) &&
+		usersCover(a.users, b.users) &&
+		(a.policy.Schedule == b.policy.Schedule || a.policy.Schedule == "always")
+}
+
+// usersCover reports whether the user restriction of a lets through all the users of b,
+// a policy without users matches everybody
+func usersCover(a *nameSet, b *nameSet) bool {
+	if len(a.names) == 0 {
+		return true
+	}
+	return len(b.names) > 0 && b.subsetOf(a)
+}
+
+// mayIntersect reports whether a and b can match the same traffic,
+// the policies with criteria the analysis does not model can match anything
+func mayIntersect(a *resolved, b *resolved) bool {
+	if a.opaque || b.opaque {
+		return true
+	}
+	return a.srcintf.intersects(b.srcintf) &&
+		a.dstintf.intersects(b.dstintf) &&
+		a.srcaddr.mayIntersect(b.srcaddr) &&
+		a.dstaddr.mayIntersect(b.dstaddr) &&
+		a.service.mayIntersect(b.service)
+}
+
+// mergeable reports whether a and b only differ in one of their member attributes,
+// and returns its name
+func mergeable(a *resolved, b *resolved) (string, bool) {
+	if !a.users.equal(b.users) || a.policy.Schedule != b.policy.Schedule {
+		return "", false
+	}
+
+	diffs := []string{}
+	if !a.srcintf.equal(b.srcintf) {
+		diffs = append(diffs, "srcintf")
+	}
+	if !a.dstintf.equal(b.dstintf) {
+		diffs = append(diffs, "dstintf")
+	}
+	if !(a.srcaddr.subsetOf(b.srcaddr) && b.srcaddr.subsetOf(a.srcaddr)) {
+		diffs = append(diffs, "srcaddr")
+	}
+	if !(a.dstaddr.subsetOf(b.dstaddr) && b.dstaddr.subsetOf(a.dstaddr)) {
+		diffs = append(diffs, "dstaddr")
+	}
+	if !(a.service.subsetOf(b.service) && b.service.subsetOf(a.service)) {
+		diffs = append(diffs, "service")
+	}
+
+	if len(diffs) != 1 {
+		return "", false
+	}
+
+	return diffs[0], true
+}
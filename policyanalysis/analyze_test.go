@@ -0,0 +1,253 @@
+package policyanalysis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	forticlient "github.com/fgtdev/fortios-sdk-go/sdkcore"
+)
+
+// testObjects returns the subnets lan and dmz, the host lan-host in lan, the FQDN address web,
+// the services HTTP, HTTPS and DNS, the ICMP service PING and the group WEB of HTTP and HTTPS
+func testObjects() *Objects {
+	o := NewObjects()
+
+	subnet := func(name string, subnet string) {
+		o.Addresses[name] = &forticlient.JSONFirewallObjectAddress{
+			JSONFirewallObjectAddressCommon: &forticlient.JSONFirewallObjectAddressCommon{Name: name, Type: "ipmask"},
+			JSONFirewallObjectAddressIPMask: &forticlient.JSONFirewallObjectAddressIPMask{Subnet: subnet},
+		}
+	}
+	subnet("lan", "10.1.0.0 255.255.255.0")
+	subnet("lan-host", "10.1.0.5 255.255.255.255")
+	subnet("dmz", "10.2.0.0 255.255.255.0")
+	o.Addresses["web"] = &forticlient.JSONFirewallObjectAddress{
+		JSONFirewallObjectAddressCommon: &forticlient.JSONFirewallObjectAddressCommon{Name: "web", Type: "fqdn"},
+		JSONFirewallObjectAddressFqdn:   &forticlient.JSONFirewallObjectAddressFqdn{Fqdn: "www.example.com"},
+	}
+
+	service := func(name string, protocol string, tcp string, udp string, icmptype string) {
+		o.Services[name] = &forticlient.JSONFirewallObjectService{
+			JSONFirewallObjectServiceCommon: &forticlient.JSONFirewallObjectServiceCommon{
+				Name: name, Protocol: protocol, TCPPortrange: tcp, UDPPortrange: udp, Icmptype: icmptype,
+			},
+		}
+	}
+	service("HTTP", "TCP/UDP/SCTP", "80", "", "")
+	service("HTTPS", "TCP/UDP/SCTP", "443", "", "")
+	service("DNS", "TCP/UDP/SCTP", "53", "53", "")
+	service("PING", "ICMP", "", "", "8")
+	o.ServiceGroups["WEB"] = &forticlient.JSONFirewallObjectServiceGroup{Name: "WEB", Member: names("HTTP", "HTTPS")}
+
+	return o
+}
+
+// testPolicy returns an enabled policy accepting HTTP from lan on port1 to anything on wan1,
+// changed by edit
+func testPolicy(id int, edit func(p *forticlient.JSONFirewallSecurityPolicy)) Policy {
+	p := &forticlient.JSONFirewallSecurityPolicy{
+		Policyid: id,
+		Status:   "enable",
+		Srcintf:  names("port1"),
+		Dstintf:  names("wan1"),
+		Srcaddr:  names("lan"),
+		Dstaddr:  names("all"),
+		Service:  names("HTTP"),
+		Action:   "accept",
+		Schedule: "always",
+	}
+	if edit != nil {
+		edit(p)
+	}
+	return Policy{ID: id, JSONFirewallSecurityPolicy: p}
+}
+
+// policy attribute changes for testPolicy
+func srcaddr(n ...string) func(p *forticlient.JSONFirewallSecurityPolicy) {
+	return func(p *forticlient.JSONFirewallSecurityPolicy) { p.Srcaddr = names(n...) }
+}
+
+func service(n ...string) func(p *forticlient.JSONFirewallSecurityPolicy) {
+	return func(p *forticlient.JSONFirewallSecurityPolicy) { p.Service = names(n...) }
+}
+
+func edits(fns ...func(p *forticlient.JSONFirewallSecurityPolicy)) func(p *forticlient.JSONFirewallSecurityPolicy) {
+	return func(p *forticlient.JSONFirewallSecurityPolicy) {
+		for _, fn := range fns {
+			fn(p)
+		}
+	}
+}
+
+func deny(p *forticlient.JSONFirewallSecurityPolicy) { p.Action = "deny" }
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []Policy
+		want     []Finding
+	}{
+		{
+			name:     "no finding",
+			policies: []Policy{testPolicy(1, nil), testPolicy(2, edits(srcaddr("dmz"), deny))},
+			want:     []Finding{},
+		},
+		{
+			name: "shadowed by another action",
+			policies: []Policy{
+				testPolicy(1, edits(service("ALL"), deny)),
+				testPolicy(2, srcaddr("lan-host")),
+			},
+			want: []Finding{{Kind: Shadowed, PolicyID: 2, OtherID: 1}},
+		},
+		{
+			name: "shadowed by other settings",
+			policies: []Policy{
+				testPolicy(1, func(p *forticlient.JSONFirewallSecurityPolicy) { p.Nat = "enable" }),
+				testPolicy(2, srcaddr("lan-host")),
+			},
+			want: []Finding{{Kind: Shadowed, PolicyID: 2, OtherID: 1}},
+		},
+		{
+			name: "redundant with an earlier policy",
+			policies: []Policy{
+				testPolicy(1, service("WEB")),
+				testPolicy(2, edits(srcaddr("lan-host"), service("HTTPS"))),
+			},
+			want: []Finding{{Kind: Redundant, PolicyID: 2, OtherID: 1}},
+		},
+		{
+			name: "redundant with a later policy",
+			policies: []Policy{
+				testPolicy(1, srcaddr("lan-host")),
+				testPolicy(2, srcaddr("dmz")),
+				testPolicy(3, srcaddr("lan", "dmz")),
+			},
+			want: []Finding{{Kind: Redundant, PolicyID: 1, OtherID: 3}, {Kind: Redundant, PolicyID: 2, OtherID: 3}},
+		},
+		{
+			name: "later policy behind an intersecting policy",
+			policies: []Policy{
+				testPolicy(1, edits(srcaddr("lan-host"), service("HTTPS"))),
+				testPolicy(2, edits(srcaddr("lan"), service("WEB"), deny)),
+				testPolicy(3, service("WEB")),
+			},
+			want: []Finding{{Kind: Shadowed, PolicyID: 3, OtherID: 2}},
+		},
+		{
+			name: "mergeable",
+			policies: []Policy{
+				testPolicy(1, nil),
+				testPolicy(2, service("HTTPS")),
+			},
+			want: []Finding{{Kind: Mergeable, PolicyID: 2, OtherID: 1, Reason: "service"}},
+		},
+		{
+			name: "mergeable behind an intersecting policy",
+			policies: []Policy{
+				testPolicy(1, nil),
+				testPolicy(2, edits(srcaddr("lan-host"), service("HTTPS"), deny)),
+				testPolicy(3, service("HTTPS")),
+			},
+			want: []Finding{},
+		},
+		{
+			name: "more than one difference",
+			policies: []Policy{
+				testPolicy(1, nil),
+				testPolicy(2, edits(srcaddr("dmz"), service("HTTPS"))),
+			},
+			want: []Finding{},
+		},
+		{
+			name: "FQDN address only matches itself",
+			policies: []Policy{
+				testPolicy(1, edits(srcaddr("web"), deny)),
+				testPolicy(2, srcaddr("web")),
+				testPolicy(3, srcaddr("lan-host")),
+			},
+			want: []Finding{{Kind: Shadowed, PolicyID: 2, OtherID: 1}},
+		},
+		{
+			name: "disabled policy ignored",
+			policies: []Policy{
+				testPolicy(1, edits(deny, func(p *forticlient.JSONFirewallSecurityPolicy) { p.Status = "disable" })),
+				testPolicy(2, srcaddr("lan-host")),
+			},
+			want: []Finding{},
+		},
+		{
+			name: "internet service policy ignored",
+			policies: []Policy{
+				testPolicy(1, edits(deny, func(p *forticlient.JSONFirewallSecurityPolicy) { p.InternetService = "enable" })),
+				testPolicy(2, srcaddr("lan-host")),
+			},
+			want: []Finding{},
+		},
+		{
+			name: "other schedule does not cover",
+			policies: []Policy{
+				testPolicy(1, edits(deny, func(p *forticlient.JSONFirewallSecurityPolicy) { p.Schedule = "business-hours" })),
+				testPolicy(2, srcaddr("lan-host")),
+			},
+			want: []Finding{},
+		},
+		{
+			name: "user policy does not cover everybody",
+			policies: []Policy{
+				testPolicy(1, edits(deny, func(p *forticlient.JSONFirewallSecurityPolicy) { p.Users = names("alice") })),
+				testPolicy(2, srcaddr("lan-host")),
+			},
+			want: []Finding{},
+		},
+	}
+
+	objs := testObjects()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Analyze(tt.policies, objs)
+			if err != nil {
+				t.Fatalf("Analyze() error = %v", err)
+			}
+
+			// the reason is only checked to name the attribute of a mergeable pair
+			for i := range got {
+				if got[i].Kind == Mergeable && i < len(tt.want) && strings.HasSuffix(got[i].Reason, " "+tt.want[i].Reason) {
+					got[i].Reason = tt.want[i].Reason
+				} else {
+					got[i].Reason = ""
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Analyze() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeErrors(t *testing.T) {
+	objs := testObjects()
+	objs.AddressGroups["loop"] = &forticlient.JSONFirewallObjectAddressGroup{Name: "loop", Member: names("loop")}
+
+	tests := []struct {
+		name     string
+		policies []Policy
+	}{
+		{"no content", []Policy{{ID: 1}}},
+		{"address group loop", []Policy{testPolicy(1, srcaddr("loop"))}},
+		{"invalid subnet", []Policy{testPolicy(1, srcaddr("bad"))}},
+	}
+	objs.Addresses["bad"] = &forticlient.JSONFirewallObjectAddress{
+		JSONFirewallObjectAddressCommon: &forticlient.JSONFirewallObjectAddressCommon{Name: "bad", Type: "ipmask"},
+		JSONFirewallObjectAddressIPMask: &forticlient.JSONFirewallObjectAddressIPMask{Subnet: "10.0.0.0 255.0.255.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Analyze(tt.policies, objs); err == nil {
+				t.Error("Analyze() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+// Package policyanalysis analyzes FortiOS firewall policies offline, from the sdkcore structures,
+// and simulates which policy matches a given traffic.
+package policyanalysis
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	forticlient "github.com/fgtdev/fortios-sdk-go/sdkcore"
+)
+
+// Policy is a firewall policy with its index value
+type Policy struct {
+	ID int
+	*forticlient.JSONFirewallSecurityPolicy
+}
+
+// Objects holds the firewall objects the policies refer to, indexed by name
+type Objects struct {
+	Addresses     map[string]*forticlient.JSONFirewallObjectAddress
+	AddressGroups map[string]*forticlient.JSONFirewallObjectAddressGroup
+	Services      map[string]*forticlient.JSONFirewallObjectService
+	ServiceGroups map[string]*forticlient.JSONFirewallObjectServiceGroup
+}
+
+// NewObjects creates an empty Objects
+func NewObjects() *Objects {
+	return &Objects{
+		Addresses:     make(map[string]*forticlient.JSONFirewallObjectAddress),
+		AddressGroups: make(map[string]*forticlient.JSONFirewallObjectAddressGroup),
+		Services:      make(map[string]*forticlient.JSONFirewallObjectService),
+		ServiceGroups: make(map[string]*forticlient.JSONFirewallObjectServiceGroup),
+	}
+}
+
+// IP protocol numbers
+const (
+	protoICMP = 1
+	protoTCP  = 6
+	protoUDP  = 17
+	protoSCTP = 132
+)
+
+// resolved is a policy with its objects resolved to sets
+type resolved struct {
+	policy   Policy
+	srcintf  *nameSet
+	dstintf  *nameSet
+	srcaddr  *ipSet
+	dstaddr  *ipSet
+	service  *svcSet
+	users    *nameSet
+	settings string
+	// opaque is true when the policy matches on criteria the analysis does not model,
+	// such as internet services or IPv6 addresses
+	opaque bool
+}
+
+// resolve resolves all the objects of the policy
+func (o *Objects) resolve(p Policy) (*resolved, error) {
+	if p.JSONFirewallSecurityPolicy == nil {
+		return nil, fmt.Errorf("policy %d has no content", p.ID)
+	}
+
+	r := &resolved{
+		policy:  p,
+		srcintf: newNameSet(forticlient.ExtractString(p.Srcintf), "any"),
+		dstintf: newNameSet(forticlient.ExtractString(p.Dstintf), "any"),
+		users: newNameSet(append(forticlient.ExtractString(p.Users),
+			forticlient.ExtractString(p.Groups)...), ""),
+		settings: settings(p.JSONFirewallSecurityPolicy),
+		opaque: p.InternetService == "enable" || p.InternetServiceSrc == "enable" ||
+			len(p.InternetServiceID) > 0 || len(p.InternetServiceSrcID) > 0 || len(p.Devices) > 0 ||
+			len(p.Srcaddr6) > 0 || len(p.Dstaddr6) > 0,
+	}
+
+	var err error
+
+	r.srcaddr, err = o.resolveAddresses(forticlient.ExtractString(p.Srcaddr))
+	if err != nil {
+		return nil, fmt.Errorf("policy %d srcaddr: %s", p.ID, err)
+	}
+
+	r.dstaddr, err = o.resolveAddresses(forticlient.ExtractString(p.Dstaddr))
+	if err != nil {
+		return nil, fmt.Errorf("policy %d dstaddr: %s", p.ID, err)
+	}
+
+	r.service, err = o.resolveServices(forticlient.ExtractString(p.Service))
+	if err != nil {
+		return nil, fmt.Errorf("policy %d service: %s", p.ID, err)
+	}
+
+	return r, nil
+}
+
+// settings returns the attributes which decide what happens to the traffic matching the policy,
+// two policies with the same settings handle their traffic the same way
+func settings(p *forticlient.JSONFirewallSecurityPolicy) string {
+	return strings.Join([]string{
+		p.Action, p.Schedule, p.Nat, p.Ippool,
+		strings.Join(forticlient.ExtractString(p.Poolname), ","),
+		p.UtmStatus, p.AvProfile, p.WebfilterProfile, p.DnsfilterProfile, p.IpsSensor,
+		p.ApplicationList, p.SslSSHProfile, p.ProfileProtocolOptions,
+		p.Logtraffic, p.LogtrafficStart, p.CapturePacket,
+	}, "|")
+}
+
+// resolveAddresses resolves address and address group names to a set of addresses
+func (o *Objects) resolveAddresses(names []string) (*ipSet, error) {
+	s := newIPSet()
+	for _, n := range names {
+		if err := o.addAddress(s, n, map[string]bool{}); err != nil {
+			return nil, err
+		}
+	}
+	s.normalize()
+	return s, nil
+}
+
+// addAddress adds the address or address group n to s, seen detects the group loops
+func (o *Objects) addAddress(s *ipSet, n string, seen map[string]bool) error {
+	if g, ok := o.AddressGroups[n]; ok && g != nil {
+		if seen[n] {
+			return fmt.Errorf("address group %s contains itself", n)
+		}
+		seen[n] = true
+		for _, m := range g.Member {
+			if err := o.addAddress(s, m.Name, seen); err != nil {
+				return err
+			}
+		}
+		delete(seen, n)
+		return nil
+	}
+
+	a, ok := o.Addresses[n]
+	if !ok || a == nil {
+		if n == "all" {
+			s.all = true
+		} else {
+			s.opaque[n] = true
+		}
+		return nil
+	}
+
+	r, ok, err := addressRange(a)
+	if err != nil {
+		return fmt.Errorf("address %s: %s", n, err)
+	}
+	if !ok {
+		s.opaque[n] = true
+		return nil
+	}
+
+	if r.from == netip.IPv4Unspecified() && r.to == netip.AddrFrom4([4]byte{255, 255, 255, 255}) {
+		s.all = true
+		return nil
+	}
+
+	s.add(r)
+	return nil
+}
+
+// addressRange returns the range of an ipmask or iprange address.
+// Returns false for the other address types.
+func addressRange(a *forticlient.JSONFirewallObjectAddress) (r ipRange, ok bool, err error) {
+	typ := ""
+	if a.JSONFirewallObjectAddressCommon != nil {
+		typ = a.Type
+	}
+
+	switch typ {
+	case "ipmask", "":
+		if a.JSONFirewallObjectAddressIPMask == nil || a.Subnet == "" {
+			return
+		}
+		var p netip.Prefix
+		p, err = a.Prefix()
+		if err != nil {
+			return
+		}
+		r = prefixRange(p)
+		ok = true
+	case "iprange":
+		if a.JSONFirewallObjectAddressIPRange == nil {
+			return
+		}
+		r.from, r.to, err = a.Range()
+		if err != nil {
+			return
+		}
+		ok = true
+	}
+
+	return
+}
+
+// prefixRange returns the range of addresses of a prefix
+func prefixRange(p netip.Prefix) ipRange {
+	p = p.Masked()
+	from := p.Addr()
+
+	b := from.AsSlice()
+	for i := p.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> uint(i%8)
+	}
+	to, _ := netip.AddrFromSlice(b)
+
+	return ipRange{from: from, to: to}
+}
+
+// resolveServices resolves service and service group names to a set of services
+func (o *Objects) resolveServices(names []string) (*svcSet, error) {
+	s := newSvcSet()
+	for _, n := range names {
+		if err := o.addService(s, n, map[string]bool{}); err != nil {
+			return nil, err
+		}
+	}
+	s.normalize()
+	return s, nil
+}
+
+// addService adds the service or service group n to s, seen detects the group loops
+func (o *Objects) addService(s *svcSet, n string, seen map[string]bool) error {
+	if g, ok := o.ServiceGroups[n]; ok && g != nil {
+		if seen[n] {
+			return fmt.Errorf("service group %s contains itself", n)
+		}
+		seen[n] = true
+		for _, m := range g.Member {
+			if err := o.addService(s, m.Name, seen); err != nil {
+				return err
+			}
+		}
+		delete(seen, n)
+		return nil
+	}
+
+	svc, ok := o.Services[n]
+	if !ok || svc == nil || svc.JSONFirewallObjectServiceCommon == nil {
+		if n == "ALL" {
+			s.all = true
+		} else {
+			s.opaque[n] = true
+		}
+		return nil
+	}
+
+	ok, err := addServiceDef(s, svc)
+	if err != nil {
+		return fmt.Errorf("service %s: %s", n, err)
+	}
+	if !ok {
+		s.opaque[n] = true
+	}
+
+	return nil
+}
+
+// addServiceDef adds the ports of a custom service to s.
+// Returns false when the service cannot be modeled, such as proxy services
+// or services restricted to source ports or destinations.
+func addServiceDef(s *svcSet, svc *forticlient.JSONFirewallObjectService) (bool, error) {
+	if (svc.JSONFirewallObjectServiceFqdn != nil && svc.Fqdn != "") ||
+		(svc.JSONFirewallObjectServiceIprange != nil && svc.Iprange != "" && svc.Iprange != "0.0.0.0") {
+		return false, nil
+	}
+
+	switch svc.Protocol {
+	case "TCP/UDP/SCTP", "":
+		for _, pr := range []struct {
+			proto int
+			spec  string
+		}{
+			{protoTCP, svc.TCPPortrange},
+			{protoUDP, svc.UDPPortrange},
+			{protoSCTP, svc.SctpPortrange},
+		} {
+			if pr.spec == "" {
+				continue
+			}
+			ports, source, err := parsePortrange(pr.spec)
+			if err != nil {
+				return false, err
+			}
+			if source {
+				return false, nil
+			}
+			s.add(pr.proto, ports)
+		}
+		return true, nil
+	case "ICMP":
+		types := portSet{{lo: 0, hi: 255}}
+		if svc.Icmptype != "" {
+			t, err := strconv.Atoi(svc.Icmptype)
+			if err != nil {
+				return false, fmt.Errorf("invalid icmptype %q", svc.Icmptype)
+			}
+			types = portSet{{lo: t, hi: t}}
+		}
+		s.add(protoICMP, types)
+		return true, nil
+	case "IP":
+		n := 0
+		if svc.ProtocolNumber != "" {
+			var err error
+			n, err = strconv.Atoi(svc.ProtocolNumber)
+			if err != nil {
+				return false, fmt.Errorf("invalid protocol-number %q", svc.ProtocolNumber)
+			}
+		}
+		if n == 0 {
+			s.all = true
+			return true, nil
+		}
+		s.add(n, portSet{{lo: 0, hi: 65535}})
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// parsePortrange parses the destination ports of a FortiOS port range,
+// such as "80-90:1024-65535 443".
+// Returns true when a range is restricted to source ports, the ones after the colon.
+func parsePortrange(spec string) (ports portSet, source bool, err error) {
+	rs, err := forticlient.ParsePortRanges(spec)
+	if err != nil {
+		return
+	}
+
+	for _, r := range rs {
+		if !r.AnySource() {
+			source = true
+		}
+		ports = append(ports, portRange{lo: r.Low, hi: r.High})
+	}
+
+	return
+}
@@ -0,0 +1,169 @@
+package policyanalysis
+
+import (
+	"fmt"
+	"strings"
+
+	forticlient "github.com/fgtdev/fortios-sdk-go/sdkcore"
+)
+
+// SNATRule is a central SNAT map entry with its index value
+type SNATRule struct {
+	ID int
+	*forticlient.JSONFirewallCentralSnatMap
+}
+
+// NATResult is the source NAT applied to a Flow
+type NATResult struct {
+	// Central is true when the rule is a central SNAT map entry, false when it is a firewall policy
+	Central bool
+	// RuleID is the central SNAT map entry or the firewall policy deciding the NAT,
+	// 0 when no rule matches and the source is not translated
+	RuleID int
+	// NAT is true when the source of the flow is translated
+	NAT bool
+	// Pools are the IP pools of the translation, empty when the source
+	// is translated to the address of the outgoing interface
+	Pools []string
+	// Unresolved lists the earlier rules which can match the flow through criteria
+	// the simulator cannot evaluate. The rule used on the device can be one of them.
+	Unresolved []int
+}
+
+// SimulatePolicyNAT returns the source NAT of the firewall policy matching the flow,
+// which decides the NAT when central NAT is disabled.
+// Returns error when an object cannot be parsed.
+func SimulatePolicyNAT(policies []Policy, objs *Objects, flow Flow) (*NATResult, error) {
+	m, err := Simulate(policies, objs, flow)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &NATResult{RuleID: m.PolicyID, Pools: []string{}, Unresolved: m.Unresolved}
+	if m.PolicyID == 0 {
+		return res, nil
+	}
+
+	for _, p := range policies {
+		if p.ID != m.PolicyID || p.JSONFirewallSecurityPolicy == nil {
+			continue
+		}
+		if p.Action != "accept" || p.Nat != "enable" {
+			break
+		}
+
+		res.NAT = true
+		if p.Ippool == "enable" {
+			res.Pools = forticlient.ExtractString(p.Poolname)
+		}
+		break
+	}
+
+	return res, nil
+}
+
+// SimulateCentralSNAT returns the first enabled central SNAT map entry of the ordered list
+// matching the flow, which decides the NAT when central NAT is enabled.
+// The rules filtering on the destination interface or the original port are unresolved
+// when the flow has no DstIntf or SrcPort.
+// Returns error when an object or a port range cannot be parsed.
+func SimulateCentralSNAT(rules []SNATRule, objs *Objects, flow Flow) (*NATResult, error) {
+	if objs == nil {
+		objs = NewObjects()
+	}
+
+	res := &NATResult{Central: true, Pools: []string{}, Unresolved: []int{}}
+
+	for _, r := range rules {
+		if r.JSONFirewallCentralSnatMap == nil {
+			return nil, fmt.Errorf("central SNAT rule %d has no content", r.ID)
+		}
+		if r.Status == "disable" {
+			continue
+		}
+
+		m, sure, err := objs.matchSNAT(r, flow)
+		if err != nil {
+			return nil, err
+		}
+		if !m {
+			continue
+		}
+		if !sure {
+			res.Unresolved = append(res.Unresolved, r.ID)
+			continue
+		}
+
+		res.RuleID = r.ID
+		if r.Nat != "disable" {
+			res.NAT = true
+			res.Pools = forticlient.ExtractString(r.NatIppool)
+		}
+		return res, nil
+	}
+
+	return res, nil
+}
+
+// matchSNAT reports whether the central SNAT rule can match the flow, and whether the answer is certain
+func (o *Objects) matchSNAT(r SNATRule, f Flow) (matched bool, sure bool, err error) {
+	srcintf := newNameSet(forticlient.ExtractString(r.Srcintf), "any")
+	if !srcintf.any && !srcintf.names[f.SrcIntf] {
+		return false, true, nil
+	}
+
+	sure = true
+
+	dstintf := newNameSet(forticlient.ExtractString(r.Dstintf), "any")
+	if !dstintf.any {
+		if f.DstIntf == "" {
+			sure = false
+		} else if !dstintf.names[f.DstIntf] {
+			return false, true, nil
+		}
+	}
+
+	if r.Protocol != 0 && r.Protocol != f.Protocol {
+		return false, true, nil
+	}
+
+	srcaddr, err := o.resolveAddresses(forticlient.ExtractString(r.OrigAddr))
+	if err != nil {
+		return false, false, fmt.Errorf("central SNAT rule %d orig-addr: %s", r.ID, err)
+	}
+	dstaddr, err := o.resolveAddresses(forticlient.ExtractString(r.DstAddr))
+	if err != nil {
+		return false, false, fmt.Errorf("central SNAT rule %d dst-addr: %s", r.ID, err)
+	}
+
+	src, srcSure := srcaddr.match(f.SrcIP)
+	dst, dstSure := dstaddr.match(f.DstIP)
+	if (!src && srcSure) || (!dst && dstSure) {
+		return false, true, nil
+	}
+	sure = sure && srcSure && dstSure
+
+	port := strings.TrimSpace(r.OrigPort)
+	if port != "" && port != "0" {
+		rs, err := forticlient.ParsePortRanges(port)
+		if err != nil {
+			return false, false, fmt.Errorf("central SNAT rule %d orig-port: %s", r.ID, err)
+		}
+
+		if f.SrcPort == 0 {
+			sure = false
+		} else {
+			in := false
+			for _, pr := range rs {
+				if f.SrcPort >= pr.Low && f.SrcPort <= pr.High {
+					in = true
+				}
+			}
+			if !in {
+				return false, true, nil
+			}
+		}
+	}
+
+	return true, sure, nil
+}
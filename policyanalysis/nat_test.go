@@ -0,0 +1,264 @@
+package policyanalysis
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+
+	forticlient "github.com/fgtdev/fortios-sdk-go/sdkcore"
+)
+
+// names returns the names as a MultValues
+func names(n ...string) forticlient.MultValues {
+	out := forticlient.MultValues{}
+	for _, v := range n {
+		out = append(out, forticlient.MultValue{Name: v})
+	}
+	return out
+}
+
+// natObjects returns objects with the subnet addresses lan and dmz, and the FQDN address web
+func natObjects() *Objects {
+	o := NewObjects()
+	o.Addresses["lan"] = &forticlient.JSONFirewallObjectAddress{
+		JSONFirewallObjectAddressCommon: &forticlient.JSONFirewallObjectAddressCommon{Name: "lan", Type: "ipmask"},
+		JSONFirewallObjectAddressIPMask: &forticlient.JSONFirewallObjectAddressIPMask{Subnet: "10.1.0.0 255.255.255.0"},
+	}
+	o.Addresses["dmz"] = &forticlient.JSONFirewallObjectAddress{
+		JSONFirewallObjectAddressCommon: &forticlient.JSONFirewallObjectAddressCommon{Name: "dmz", Type: "ipmask"},
+		JSONFirewallObjectAddressIPMask: &forticlient.JSONFirewallObjectAddressIPMask{Subnet: "10.2.0.0 255.255.255.0"},
+	}
+	o.Addresses["web"] = &forticlient.JSONFirewallObjectAddress{
+		JSONFirewallObjectAddressCommon: &forticlient.JSONFirewallObjectAddressCommon{Name: "web", Type: "fqdn"},
+		JSONFirewallObjectAddressFqdn:   &forticlient.JSONFirewallObjectAddressFqdn{Fqdn: "www.example.com"},
+	}
+	return o
+}
+
+// snatRule returns an enabled rule from port1 to wan1 translating the lan addresses to any destination
+func snatRule(id int, edit func(r *forticlient.JSONFirewallCentralSnatMap)) SNATRule {
+	r := &forticlient.JSONFirewallCentralSnatMap{
+		Policyid: id,
+		Status:   "enable",
+		Srcintf:  names("port1"),
+		Dstintf:  names("wan1"),
+		OrigAddr: names("lan"),
+		DstAddr:  names("all"),
+		Nat:      "enable",
+	}
+	if edit != nil {
+		edit(r)
+	}
+	return SNATRule{ID: id, JSONFirewallCentralSnatMap: r}
+}
+
+func natFlow() Flow {
+	return Flow{
+		SrcIntf:  "port1",
+		DstIntf:  "wan1",
+		SrcIP:    netip.MustParseAddr("10.1.0.5"),
+		DstIP:    netip.MustParseAddr("203.0.113.10"),
+		Protocol: protoTCP,
+		SrcPort:  40000,
+		Port:     443,
+	}
+}
+
+func TestMatchSNAT(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    func(r *forticlient.JSONFirewallCentralSnatMap)
+		flow    func(f *Flow)
+		matched bool
+		sure    bool
+	}{
+		{name: "match", matched: true, sure: true},
+		{
+			name:    "other source interface",
+			flow:    func(f *Flow) { f.SrcIntf = "port2" },
+			matched: false, sure: true,
+		},
+		{
+			name:    "any source interface",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.Srcintf = names("any") },
+			flow:    func(f *Flow) { f.SrcIntf = "port2" },
+			matched: true, sure: true,
+		},
+		{
+			name:    "other destination interface",
+			flow:    func(f *Flow) { f.DstIntf = "wan2" },
+			matched: false, sure: true,
+		},
+		{
+			name:    "unknown destination interface",
+			flow:    func(f *Flow) { f.DstIntf = "" },
+			matched: true, sure: false,
+		},
+		{
+			name:    "any destination interface",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.Dstintf = names("any") },
+			flow:    func(f *Flow) { f.DstIntf = "" },
+			matched: true, sure: true,
+		},
+		{
+			name:    "protocol matches",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.Protocol = protoTCP },
+			matched: true, sure: true,
+		},
+		{
+			name:    "other protocol",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.Protocol = protoUDP },
+			matched: false, sure: true,
+		},
+		{
+			name:    "source outside orig-addr",
+			flow:    func(f *Flow) { f.SrcIP = netip.MustParseAddr("10.2.0.5") },
+			matched: false, sure: true,
+		},
+		{
+			name:    "destination outside dst-addr",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.DstAddr = names("dmz") },
+			matched: false, sure: true,
+		},
+		{
+			name:    "FQDN destination",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.DstAddr = names("web") },
+			matched: true, sure: false,
+		},
+		{
+			name:    "source in orig-port",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.OrigPort = "1024-65535" },
+			matched: true, sure: true,
+		},
+		{
+			name:    "source outside orig-port",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.OrigPort = "1-1023" },
+			matched: false, sure: true,
+		},
+		{
+			name:    "orig-port 0 is any port",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.OrigPort = "0" },
+			flow:    func(f *Flow) { f.SrcPort = 0 },
+			matched: true, sure: true,
+		},
+		{
+			name:    "unknown source port",
+			rule:    func(r *forticlient.JSONFirewallCentralSnatMap) { r.OrigPort = "1024-65535" },
+			flow:    func(f *Flow) { f.SrcPort = 0 },
+			matched: true, sure: false,
+		},
+	}
+
+	objs := natObjects()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := natFlow()
+			if tt.flow != nil {
+				tt.flow(&f)
+			}
+
+			matched, sure, err := objs.matchSNAT(snatRule(1, tt.rule), f)
+			if err != nil {
+				t.Fatalf("matchSNAT() error = %v", err)
+			}
+			if matched != tt.matched || sure != tt.sure {
+				t.Errorf("matchSNAT() = %v, %v, want %v, %v", matched, sure, tt.matched, tt.sure)
+			}
+		})
+	}
+}
+
+func TestMatchSNATErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		rule func(r *forticlient.JSONFirewallCentralSnatMap)
+	}{
+		{"invalid orig-port", func(r *forticlient.JSONFirewallCentralSnatMap) { r.OrigPort = "http" }},
+		{"group loop", func(r *forticlient.JSONFirewallCentralSnatMap) { r.OrigAddr = names("loop") }},
+	}
+
+	objs := natObjects()
+	objs.AddressGroups["loop"] = &forticlient.JSONFirewallObjectAddressGroup{Name: "loop", Member: names("loop")}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := objs.matchSNAT(snatRule(1, tt.rule), natFlow()); err == nil {
+				t.Error("matchSNAT() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestSimulateCentralSNAT(t *testing.T) {
+	disabled := func(r *forticlient.JSONFirewallCentralSnatMap) { r.Status = "disable" }
+	toDMZ := func(r *forticlient.JSONFirewallCentralSnatMap) { r.DstAddr = names("dmz") }
+	toWeb := func(r *forticlient.JSONFirewallCentralSnatMap) { r.DstAddr = names("web") }
+	pool := func(r *forticlient.JSONFirewallCentralSnatMap) { r.NatIppool = names("pool1", "pool2") }
+	noNAT := func(r *forticlient.JSONFirewallCentralSnatMap) { r.Nat = "disable" }
+
+	tests := []struct {
+		name  string
+		rules []SNATRule
+		want  NATResult
+	}{
+		{
+			name: "no rule",
+			want: NATResult{Central: true, Pools: []string{}, Unresolved: []int{}},
+		},
+		{
+			name:  "outgoing interface address",
+			rules: []SNATRule{snatRule(1, nil)},
+			want:  NATResult{Central: true, RuleID: 1, NAT: true, Pools: []string{}, Unresolved: []int{}},
+		},
+		{
+			name:  "first matching rule",
+			rules: []SNATRule{snatRule(3, toDMZ), snatRule(1, pool), snatRule(2, nil)},
+			want:  NATResult{Central: true, RuleID: 1, NAT: true, Pools: []string{"pool1", "pool2"}, Unresolved: []int{}},
+		},
+		{
+			name:  "disabled rule skipped",
+			rules: []SNATRule{snatRule(1, func(r *forticlient.JSONFirewallCentralSnatMap) { disabled(r); pool(r) }), snatRule(2, nil)},
+			want:  NATResult{Central: true, RuleID: 2, NAT: true, Pools: []string{}, Unresolved: []int{}},
+		},
+		{
+			name:  "NAT disabled",
+			rules: []SNATRule{snatRule(1, func(r *forticlient.JSONFirewallCentralSnatMap) { noNAT(r); pool(r) })},
+			want:  NATResult{Central: true, RuleID: 1, Pools: []string{}, Unresolved: []int{}},
+		},
+		{
+			name:  "unresolved earlier rule",
+			rules: []SNATRule{snatRule(1, toWeb), snatRule(2, pool)},
+			want:  NATResult{Central: true, RuleID: 2, NAT: true, Pools: []string{"pool1", "pool2"}, Unresolved: []int{1}},
+		},
+		{
+			name:  "only unresolved rules",
+			rules: []SNATRule{snatRule(1, toWeb), snatRule(2, toDMZ)},
+			want:  NATResult{Central: true, Pools: []string{}, Unresolved: []int{1}},
+		},
+	}
+
+	objs := natObjects()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SimulateCentralSNAT(tt.rules, objs, natFlow())
+			if err != nil {
+				t.Fatalf("SimulateCentralSNAT() error = %v", err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("SimulateCentralSNAT() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimulateCentralSNATErrors(t *testing.T) {
+	rules := []SNATRule{{ID: 1}}
+	if _, err := SimulateCentralSNAT(rules, nil, natFlow()); err == nil {
+		t.Error("SimulateCentralSNAT() error = nil, want error for a rule without content")
+	}
+
+	rules = []SNATRule{snatRule(1, func(r *forticlient.JSONFirewallCentralSnatMap) { r.OrigPort = "80-" })}
+	if _, err := SimulateCentralSNAT(rules, nil, natFlow()); err == nil {
+		t.Error("SimulateCentralSNAT() error = nil, want error for an invalid orig-port")
+	}
+}
@@ -0,0 +1,334 @@
+package policyanalysis
+
+import (
+	"net/netip"
+	"sort"
+)
+
+// ipRange is an inclusive range of addresses of the same family
+type ipRange struct {
+	from netip.Addr
+	to   netip.Addr
+}
+
+// ipSet is a set of addresses. The addresses which cannot be resolved to ranges,
+// such as FQDN or geography addresses, are kept as opaque names.
+type ipSet struct {
+	all    bool
+	ranges []ipRange
+	opaque map[string]bool
+}
+
+func newIPSet() *ipSet {
+	return &ipSet{opaque: make(map[string]bool)}
+}
+
+// add adds a range to the set
+func (s *ipSet) add(r ipRange) {
+	s.ranges = append(s.ranges, r)
+}
+
+// merge adds the content of o to the set
+func (s *ipSet) merge(o *ipSet) {
+	if o.all {
+		s.all = true
+	}
+	s.ranges = append(s.ranges, o.ranges...)
+	for n := range o.opaque {
+		s.opaque[n] = true
+	}
+}
+
+// normalize sorts the ranges and merges the overlapping and adjacent ones
+func (s *ipSet) normalize() {
+	if len(s.ranges) < 2 {
+		return
+	}
+
+	sort.Slice(s.ranges, func(i, j int) bool {
+		return s.ranges[i].from.Less(s.ranges[j].from)
+	})
+
+	out := s.ranges[:1]
+	for _, r := range s.ranges[1:] {
+		last := &out[len(out)-1]
+		if last.to.Is4() == r.from.Is4() && (!last.to.Less(r.from) || last.to.Next() == r.from) {
+			if last.to.Less(r.to) {
+				last.to = r.to
+			}
+			continue
+		}
+		out = append(out, r)
+	}
+	s.ranges = out
+}
+
+// contains reports whether the set contains the whole range r
+func (s *ipSet) containsRange(r ipRange) bool {
+	for _, o := range s.ranges {
+		if !r.from.Less(o.from) && !o.to.Less(r.to) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAddr reports whether the set contains the address a
+func (s *ipSet) containsAddr(a netip.Addr) bool {
+	if s.all {
+		return true
+	}
+	return s.containsRange(ipRange{from: a, to: a})
+}
+
+// subsetOf reports whether every address of s is in o.
+// The opaque names of s must be opaque names of o too.
+func (s *ipSet) subsetOf(o *ipSet) bool {
+	if o.all {
+		return true
+	}
+	if s.all {
+		return false
+	}
+	for _, r := range s.ranges {
+		if !o.containsRange(r) {
+			return false
+		}
+	}
+	for n := range s.opaque {
+		if !o.opaque[n] {
+			return false
+		}
+	}
+	return true
+}
+
+// mayIntersect reports whether s and o can have a common address.
+// The opaque names are assumed to intersect with anything.
+func (s *ipSet) mayIntersect(o *ipSet) bool {
+	if s.empty() || o.empty() {
+		return false
+	}
+	if s.all || o.all || len(s.opaque) > 0 || len(o.opaque) > 0 {
+		return true
+	}
+	for _, a := range s.ranges {
+		for _, b := range o.ranges {
+			if !a.to.Less(b.from) && !b.to.Less(a.from) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// empty reports whether the set has no address
+func (s *ipSet) empty() bool {
+	return !s.all && len(s.ranges) == 0 && len(s.opaque) == 0
+}
+
+// portRange is an inclusive range of ports, or of ICMP types
+type portRange struct {
+	lo int
+	hi int
+}
+
+// portSet is a set of ports
+type portSet []portRange
+
+// normalize sorts the ranges and merges the overlapping and adjacent ones
+func (s portSet) normalize() portSet {
+	if len(s) < 2 {
+		return s
+	}
+
+	sort.Slice(s, func(i, j int) bool {
+		return s[i].lo < s[j].lo
+	})
+
+	out := s[:1]
+	for _, r := range s[1:] {
+		last := &out[len(out)-1]
+		if r.lo <= last.hi+1 {
+			if r.hi > last.hi {
+				last.hi = r.hi
+			}
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// subsetOf reports whether every port of s is in o, both sets must be normalized
+func (s portSet) subsetOf(o portSet) bool {
+	for _, r := range s {
+		found := false
+		for _, x := range o {
+			if r.lo >= x.lo && r.hi <= x.hi {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// intersects reports whether s and o have a common port
+func (s portSet) intersects(o portSet) bool {
+	for _, a := range s {
+		for _, b := range o {
+			if a.lo <= b.hi && b.lo <= a.hi {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// contains reports whether the port p is in the set
+func (s portSet) contains(p int) bool {
+	for _, r := range s {
+		if p >= r.lo && p <= r.hi {
+			return true
+		}
+	}
+	return false
+}
+
+// svcSet is a set of services, as ports or ICMP types per IP protocol number.
+// The services which cannot be resolved, such as proxy services, are kept as opaque names.
+type svcSet struct {
+	all    bool
+	protos map[int]portSet
+	opaque map[string]bool
+}
+
+func newSvcSet() *svcSet {
+	return &svcSet{protos: make(map[int]portSet), opaque: make(map[string]bool)}
+}
+
+// add adds the ports of the protocol to the set
+func (s *svcSet) add(proto int, ports portSet) {
+	s.protos[proto] = append(s.protos[proto], ports...)
+}
+
+// merge adds the content of o to the set
+func (s *svcSet) merge(o *svcSet) {
+	if o.all {
+		s.all = true
+	}
+	for p, ports := range o.protos {
+		s.add(p, ports)
+	}
+	for n := range o.opaque {
+		s.opaque[n] = true
+	}
+}
+
+// normalize normalizes the port sets of all the protocols
+func (s *svcSet) normalize() {
+	for p, ports := range s.protos {
+		s.protos[p] = ports.normalize()
+	}
+}
+
+// subsetOf reports whether every service of s is in o
+func (s *svcSet) subsetOf(o *svcSet) bool {
+	if o.all {
+		return true
+	}
+	if s.all {
+		return false
+	}
+	for p, ports := range s.protos {
+		if !ports.subsetOf(o.protos[p]) {
+			return false
+		}
+	}
+	for n := range s.opaque {
+		if !o.opaque[n] {
+			return false
+		}
+	}
+	return true
+}
+
+// mayIntersect reports whether s and o can have a common service.
+// The opaque names are assumed to intersect with anything.
+func (s *svcSet) mayIntersect(o *svcSet) bool {
+	if s.empty() || o.empty() {
+		return false
+	}
+	if s.all || o.all || len(s.opaque) > 0 || len(o.opaque) > 0 {
+		return true
+	}
+	for p, ports := range s.protos {
+		if ports.intersects(o.protos[p]) {
+			return true
+		}
+	}
+	return false
+}
+
+// empty reports whether the set has no service
+func (s *svcSet) empty() bool {
+	return !s.all && len(s.protos) == 0 && len(s.opaque) == 0
+}
+
+// nameSet is a set of names, such as interfaces, where "any" matches everything
+type nameSet struct {
+	any   bool
+	names map[string]bool
+}
+
+func newNameSet(names []string, anyName string) *nameSet {
+	s := &nameSet{names: make(map[string]bool)}
+	for _, n := range names {
+		if n == anyName {
+			s.any = true
+		}
+		s.names[n] = true
+	}
+	return s
+}
+
+// subsetOf reports whether every name of s is in o
+func (s *nameSet) subsetOf(o *nameSet) bool {
+	if o.any {
+		return true
+	}
+	if s.any {
+		return false
+	}
+	for n := range s.names {
+		if !o.names[n] {
+			return false
+		}
+	}
+	return true
+}
+
+// intersects reports whether s and o have a common name
+func (s *nameSet) intersects(o *nameSet) bool {
+	if len(s.names) == 0 || len(o.names) == 0 {
+		return false
+	}
+	if s.any || o.any {
+		return true
+	}
+	for n := range s.names {
+		if o.names[n] {
+			return true
+		}
+	}
+	return false
+}
+
+// equal reports whether s and o have the same names
+func (s *nameSet) equal(o *nameSet) bool {
+	return s.subsetOf(o) && o.subsetOf(s)
+}
@@ -0,0 +1,191 @@
+package policyanalysis
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+
+	forticlient "github.com/fgtdev/fortios-sdk-go/sdkcore"
+)
+
+// Flow is the traffic to find the matching policy for.
+// Protocol is the IP protocol number, Port is the destination port for TCP, UDP and SCTP
+// and the ICMP type for ICMP.
+// DstIntf and SrcPort are only used by SimulateCentralSNAT, they are empty when unknown.
+type Flow struct {
+	SrcIntf  string
+	DstIntf  string
+	SrcIP    netip.Addr
+	DstIP    netip.Addr
+	Protocol int
+	SrcPort  int
+	Port     int
+}
+
+// MatchResult is the policy matching a Flow
+type MatchResult struct {
+	// PolicyID is the matching policy, 0 when the traffic hits the implicit deny policy
+	PolicyID int
+	// Unresolved lists the earlier policies which can match the flow through criteria
+	// the simulator cannot evaluate, such as FQDN addresses, internet services, users
+	// or schedules other than always.
+	// The policy matched on the device can be one of them.
+	Unresolved []int
+}
+
+// Simulate returns the first enabled policy of the ordered list matching the flow,
+// without contacting the device. The destination interface is not evaluated,
+// it depends on the routing table. The flow has no time, a policy with a schedule
+// other than always is unresolved.
+// Returns error when an object cannot be parsed.
+func Simulate(policies []Policy, objs *Objects, flow Flow) (*MatchResult, error) {
+	if objs == nil {
+		objs = NewObjects()
+	}
+
+	res := &MatchResult{Unresolved: []int{}}
+
+	for _, p := range policies {
+		if p.JSONFirewallSecurityPolicy != nil && p.Status == "disable" {
+			continue
+		}
+
+		r, err := objs.resolve(p)
+		if err != nil {
+			return nil, err
+		}
+
+		m, sure := r.match(flow)
+		if !m {
+			continue
+		}
+		if !sure {
+			res.Unresolved = append(res.Unresolved, p.ID)
+			continue
+		}
+
+		res.PolicyID = p.ID
+		return res, nil
+	}
+
+	return res, nil
+}
+
+// match reports whether the policy can match the flow, and whether the answer is certain
+func (r *resolved) match(f Flow) (matched bool, sure bool) {
+	if !r.srcintf.any && !r.srcintf.names[f.SrcIntf] {
+		return false, true
+	}
+
+	src, srcSure := r.srcaddr.match(f.SrcIP)
+	dst, dstSure := r.dstaddr.match(f.DstIP)
+	svc, svcSure := r.service.match(f.Protocol, f.Port)
+
+	if (!src && srcSure) || (!dst && dstSure) || (!svc && svcSure) {
+		return false, true
+	}
+
+	return true, srcSure && dstSure && svcSure && !r.opaque && len(r.users.names) == 0 && r.alwaysActive()
+}
+
+// alwaysActive reports whether the schedule of the policy is always,
+// the default of FortiOS when the policy has none
+func (r *resolved) alwaysActive() bool {
+	return r.policy.Schedule == "" || r.policy.Schedule == "always"
+}
+
+// match reports whether the address is in the set, and whether the answer is certain
+func (s *ipSet) match(a netip.Addr) (matched bool, sure bool) {
+	if s.containsAddr(a) {
+		return true, true
+	}
+	if len(s.opaque) > 0 {
+		return true, false
+	}
+	return false, true
+}
+
+// match reports whether the service is in the set, and whether the answer is certain
+func (s *svcSet) match(proto int, port int) (matched bool, sure bool) {
+	if s.all {
+		return true, true
+	}
+	if ports, ok := s.protos[proto]; ok && ports.contains(port) {
+		return true, true
+	}
+	if len(s.opaque) > 0 {
+		return true, false
+	}
+	return false, true
+}
+
+// SimulateOnline asks the device which policy matches the flow
+// Returns error for service API and SDK errors.
+func SimulateOnline(c *forticlient.FortiSDKClient, flow Flow) (*MatchResult, error) {
+	params := &forticlient.JSONFirewallPolicyLookup{
+		Srcintf:  flow.SrcIntf,
+		Sourceip: flow.SrcIP.String(),
+		Dest:     flow.DstIP.String(),
+	}
+
+	switch flow.Protocol {
+	case protoTCP:
+		params.Protocol = "tcp"
+		params.Destport = flow.Port
+	case protoUDP:
+		params.Protocol = "udp"
+		params.Destport = flow.Port
+	case protoICMP:
+		params.Protocol = "icmp"
+		params.Icmptype = flow.Port
+	default:
+		params.Protocol = strconv.Itoa(flow.Protocol)
+		params.Destport = flow.Port
+	}
+
+	out, err := c.LookupFirewallPolicy(params)
+	if err != nil {
+		return nil, err
+	}
+	if !out.Success {
+		return nil, fmt.Errorf("policy lookup failed for %s -> %s", params.Sourceip, params.Dest)
+	}
+
+	return &MatchResult{PolicyID: int(out.PolicyID), Unresolved: []int{}}, nil
+}
+
+// Comparison holds the offline and online answers for the same flow
+type Comparison struct {
+	Offline *MatchResult
+	Online  *MatchResult
+	// Agree is true when both answers are the same policy,
+	// or when the online answer is one of the unresolved offline candidates
+	Agree bool
+}
+
+// Compare runs the offline and online simulations of the flow
+// Returns error for object parsing, service API and SDK errors.
+func Compare(c *forticlient.FortiSDKClient, policies []Policy, objs *Objects, flow Flow) (*Comparison, error) {
+	offline, err := Simulate(policies, objs, flow)
+	if err != nil {
+		return nil, err
+	}
+
+	online, err := SimulateOnline(c, flow)
+	if err != nil {
+		return nil, err
+	}
+
+	cmp := &Comparison{
+		Offline: offline,
+		Online:  online,
+		Agree:   offline.PolicyID == online.PolicyID,
+	}
+	for _, id := range offline.Unresolved {
+		if id == online.PolicyID {
+			cmp.Agree = true
+		}
+	}
+
+	return cmp, nil
+}
@@ -0,0 +1,154 @@
+package policyanalysis
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+
+	forticlient "github.com/fgtdev/fortios-sdk-go/sdkcore"
+)
+
+// testFlow is a HTTPS flow from lan-host on port1 to the Internet
+func testFlow() Flow {
+	return Flow{
+		SrcIntf:  "port1",
+		SrcIP:    netip.MustParseAddr("10.1.0.5"),
+		DstIP:    netip.MustParseAddr("203.0.113.10"),
+		Protocol: protoTCP,
+		Port:     443,
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	tests := []struct {
+		name     string
+		policies []Policy
+		flow     func(f *Flow)
+		want     MatchResult
+	}{
+		{
+			name:     "implicit deny",
+			policies: []Policy{testPolicy(1, nil)},
+			want:     MatchResult{Unresolved: []int{}},
+		},
+		{
+			name:     "first matching policy",
+			policies: []Policy{testPolicy(1, nil), testPolicy(2, service("WEB")), testPolicy(3, service("ALL"))},
+			want:     MatchResult{PolicyID: 2, Unresolved: []int{}},
+		},
+		{
+			name:     "other source interface",
+			policies: []Policy{testPolicy(1, service("ALL"))},
+			flow:     func(f *Flow) { f.SrcIntf = "port2" },
+			want:     MatchResult{Unresolved: []int{}},
+		},
+		{
+			name: "any source interface",
+			policies: []Policy{testPolicy(1, edits(service("ALL"), func(p *forticlient.JSONFirewallSecurityPolicy) {
+				p.Srcintf = names("any")
+			}))},
+			flow: func(f *Flow) { f.SrcIntf = "port2" },
+			want: MatchResult{PolicyID: 1, Unresolved: []int{}},
+		},
+		{
+			name:     "source outside the addresses",
+			policies: []Policy{testPolicy(1, edits(srcaddr("dmz"), service("ALL"))), testPolicy(2, service("ALL"))},
+			want:     MatchResult{PolicyID: 2, Unresolved: []int{}},
+		},
+		{
+			name:     "ICMP type",
+			policies: []Policy{testPolicy(1, service("PING"))},
+			flow:     func(f *Flow) { f.Protocol, f.Port = protoICMP, 8 },
+			want:     MatchResult{PolicyID: 1, Unresolved: []int{}},
+		},
+		{
+			name:     "UDP port",
+			policies: []Policy{testPolicy(1, service("HTTPS")), testPolicy(2, service("DNS"))},
+			flow:     func(f *Flow) { f.Protocol, f.Port = protoUDP, 53 },
+			want:     MatchResult{PolicyID: 2, Unresolved: []int{}},
+		},
+		{
+			name: "disabled policy skipped",
+			policies: []Policy{
+				testPolicy(1, edits(service("ALL"), func(p *forticlient.JSONFirewallSecurityPolicy) { p.Status = "disable" })),
+				testPolicy(2, service("ALL")),
+			},
+			want: MatchResult{PolicyID: 2, Unresolved: []int{}},
+		},
+		{
+			name: "unresolved FQDN address",
+			policies: []Policy{
+				testPolicy(1, edits(service("ALL"), func(p *forticlient.JSONFirewallSecurityPolicy) { p.Dstaddr = names("web") })),
+				testPolicy(2, service("ALL")),
+			},
+			want: MatchResult{PolicyID: 2, Unresolved: []int{1}},
+		},
+		{
+			name:     "unresolved unknown service",
+			policies: []Policy{testPolicy(1, service("custom-proxy")), testPolicy(2, service("ALL"))},
+			want:     MatchResult{PolicyID: 2, Unresolved: []int{1}},
+		},
+		{
+			name: "unresolved users",
+			policies: []Policy{
+				testPolicy(1, edits(service("ALL"), func(p *forticlient.JSONFirewallSecurityPolicy) { p.Groups = names("staff") })),
+				testPolicy(2, service("ALL")),
+			},
+			want: MatchResult{PolicyID: 2, Unresolved: []int{1}},
+		},
+		{
+			name: "unresolved internet service",
+			policies: []Policy{
+				testPolicy(1, edits(service("ALL"), func(p *forticlient.JSONFirewallSecurityPolicy) { p.InternetService = "enable" })),
+				testPolicy(2, service("ALL")),
+			},
+			want: MatchResult{PolicyID: 2, Unresolved: []int{1}},
+		},
+		{
+			name: "unresolved schedule",
+			policies: []Policy{
+				testPolicy(1, edits(service("ALL"), func(p *forticlient.JSONFirewallSecurityPolicy) { p.Schedule = "business-hours" })),
+				testPolicy(2, edits(service("ALL"), func(p *forticlient.JSONFirewallSecurityPolicy) { p.Schedule = "" })),
+			},
+			want: MatchResult{PolicyID: 2, Unresolved: []int{1}},
+		},
+		{
+			name: "only unresolved policies",
+			policies: []Policy{
+				testPolicy(1, service("custom-proxy")),
+				testPolicy(2, edits(service("ALL"), func(p *forticlient.JSONFirewallSecurityPolicy) { p.Schedule = "business-hours" })),
+			},
+			want: MatchResult{Unresolved: []int{1, 2}},
+		},
+	}
+
+	objs := testObjects()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := testFlow()
+			if tt.flow != nil {
+				tt.flow(&f)
+			}
+
+			got, err := Simulate(tt.policies, objs, f)
+			if err != nil {
+				t.Fatalf("Simulate() error = %v", err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("Simulate() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSimulateErrors(t *testing.T) {
+	if _, err := Simulate([]Policy{{ID: 1}}, nil, testFlow()); err == nil {
+		t.Error("Simulate() error = nil, want error for a policy without content")
+	}
+
+	objs := testObjects()
+	objs.ServiceGroups["loop"] = &forticlient.JSONFirewallObjectServiceGroup{Name: "loop", Member: names("loop")}
+	if _, err := Simulate([]Policy{testPolicy(1, service("loop"))}, objs, testFlow()); err == nil {
+		t.Error("Simulate() error = nil, want error for a service group loop")
+	}
+}
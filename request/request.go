@@ -2,10 +2,12 @@ package request
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -60,6 +62,20 @@ func (r *Request) FillUrlParams(dstId int, alterPos string) {
 	r.HTTPRequest.Form.Set("alter_position", alterPos)
 }
 
+// Store a generic URL request param
+// Used by the monitor APIs which take their arguments from the URL query,
+// such as config backup. A "vdom" param overrides the vdom of the Auth.
+// @key: param name
+// @value: param value
+func (r *Request) FillUrlParam(key string, value string) {
+
+	if r.HTTPRequest.Form == nil {
+		r.HTTPRequest.Form = make(map[string][]string)
+	}
+
+	r.HTTPRequest.Form.Set(key, value)
+}
+
 // Build Request header
 
 // Build Request Sign/Login Info
@@ -72,13 +88,8 @@ func (r *Request) Send() error {
 
 	//httpReq.URL, err = url.Parse(clientInfo.Endpoint + operation.HTTPPath)
 
-	r.HTTPRequest.Header.Set("Content-Type", "application/json")
-	u := buildURL(r)
-
-	var err error
-	r.HTTPRequest.URL, err = url.Parse(u)
+	u, err := r.prepare()
 	if err != nil {
-		log.Fatal(err)
 		return err
 	}
 
@@ -110,13 +121,55 @@ func (r *Request) Send() error {
 	return err
 }
 
+// SendOnce sends request data to FortiOS once, without retrying on errors.
+// The request is canceled when ctx is done, it is used to probe a device
+// which may not answer, such as a rebooting one.
+// If errors are encountered, it returns the error.
+func (r *Request) SendOnce(ctx context.Context) error {
+	if _, err := r.prepare(); err != nil {
+		return err
+	}
+
+	r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
+
+	rsp, err := r.Config.HTTPCon.Do(r.HTTPRequest)
+	r.HTTPResponse = rsp
+	if err != nil {
+		return fmt.Errorf("Error found: %s", err)
+	}
+
+	return nil
+}
+
+// prepare sets the headers and the URL of the request, it returns the URL
+func (r *Request) prepare() (string, error) {
+	r.HTTPRequest.Header.Set("Content-Type", "application/json")
+	if r.Config.TransactionID != 0 {
+		r.HTTPRequest.Header.Set("X-TRANSACTION-ID", strconv.Itoa(r.Config.TransactionID))
+	}
+	u := buildURL(r)
+
+	var err error
+	r.HTTPRequest.URL, err = url.Parse(u)
+	if err != nil {
+		log.Fatal(err)
+		return "", err
+	}
+
+	return u, nil
+}
+
 func buildURL(r *Request) string {
 	u := "https://"
 	u += r.Config.FwTarget
 	u += r.Path
 	u += "?"
 
-	if r.Config.Auth.Vdom != "" {
+	if r.HTTPRequest.Form.Get("vdom") != "" {
+		u += "vdom="
+		u += url.QueryEscape(r.HTTPRequest.Form.Get("vdom"))
+		u += "&"
+	} else if r.Config.Auth.Vdom != "" {
 		u += "vdom="
 		u += r.Config.Auth.Vdom
 		u += "&"
@@ -130,6 +183,23 @@ func buildURL(r *Request) string {
 		u += "&"
 	}
 
+	keys := make([]string, 0, len(r.HTTPRequest.Form))
+	for k := range r.HTTPRequest.Form {
+		switch k {
+		case "vdom", "policy_dst_id", "alter_position":
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		u += url.QueryEscape(k)
+		u += "="
+		u += url.QueryEscape(r.HTTPRequest.Form.Get(k))
+		u += "&"
+	}
+
 	u += "access_token="
 	u += r.Config.Auth.Token
 
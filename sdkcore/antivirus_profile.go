@@ -0,0 +1,621 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONAntivirusProfile contains the parameters for Create and Update API function
+type JSONAntivirusProfile struct {
+	Name            string                    `json:"name"`
+	Comment         string                    `json:"comment"`
+	FeatureSet      string                    `json:"feature-set,omitempty"`
+	ReplacemsgGroup string                    `json:"replacemsg-group"`
+	ScanMode        string                    `json:"scan-mode,omitempty"`
+	AvVirusLog      string                    `json:"av-virus-log"`
+	ExtendedLog     string                    `json:"extended-log"`
+	HTTP            *AntivirusProfileProtocol `json:"http,omitempty"`
+	Ftp             *AntivirusProfileProtocol `json:"ftp,omitempty"`
+	Imap            *AntivirusProfileProtocol `json:"imap,omitempty"`
+	Pop3            *AntivirusProfileProtocol `json:"pop3,omitempty"`
+	Smtp            *AntivirusProfileProtocol `json:"smtp,omitempty"`
+	Mapi            *AntivirusProfileProtocol `json:"mapi,omitempty"`
+	Nntp            *AntivirusProfileProtocol `json:"nntp,omitempty"`
+	Cifs            *AntivirusProfileProtocol `json:"cifs,omitempty"`
+	SSH             *AntivirusProfileProtocol `json:"ssh,omitempty"`
+}
+
+// AntivirusProfileProtocol contains the scanning parameters of a protocol of an antivirus profile
+type AntivirusProfileProtocol struct {
+	AvScan             string `json:"av-scan,omitempty"`
+	OutbreakPrevention string `json:"outbreak-prevention,omitempty"`
+	ExternalBlocklist  string `json:"external-blocklist,omitempty"`
+	Quarantine         string `json:"quarantine,omitempty"`
+	ArchiveBlock       string `json:"archive-block,omitempty"`
+	ArchiveLog         string `json:"archive-log,omitempty"`
+	Emulator           string `json:"emulator,omitempty"`
+}
+
+// JSONCreateAntivirusProfileOutput contains the output results for Create API function
+type JSONCreateAntivirusProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateAntivirusProfileOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateAntivirusProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateAntivirusProfile API operation for FortiOS creates a new antivirus profile for firewall policies.
+// Returns the index value of the antivirus profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the antivirus - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateAntivirusProfile(params *JSONAntivirusProfile) (output *JSONCreateAntivirusProfileOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/antivirus/profile"
+	output = &JSONCreateAntivirusProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateAntivirusProfile API operation for FortiOS updates the specified antivirus profile for firewall policies.
+// Returns the index value of the antivirus profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the antivirus - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateAntivirusProfile(params *JSONAntivirusProfile, mkey string) (output *JSONUpdateAntivirusProfileOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/antivirus/profile"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateAntivirusProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteAntivirusProfile API operation for FortiOS deletes the specified antivirus profile for firewall policies.
+// Returns error for service API and SDK errors.
+// See the antivirus - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteAntivirusProfile(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/antivirus/profile"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadAntivirusProfile API operation for FortiOS gets the antivirus profile for firewall policies
+// with the specified index value.
+// Returns the requested antivirus profile value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the antivirus - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadAntivirusProfile(mkey string) (output *JSONAntivirusProfile, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/antivirus/profile"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONAntivirusProfile{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillAntivirusProfile(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListAntivirusProfiles API operation for FortiOS gets all the antivirus profiles for firewall policies.
+// Returns the antivirus profiles when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the antivirus - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListAntivirusProfiles() (output []*JSONAntivirusProfile, err error) {
+	results, err := c.listCmdbTable("antivirus/profile")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONAntivirusProfile, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONAntivirusProfile{}
+		fillAntivirusProfile(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillAntivirusProfile fills output from a antivirus profile of the response
+func fillAntivirusProfile(output *JSONAntivirusProfile, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["feature-set"] != nil {
+		output.FeatureSet = mapTmp["feature-set"].(string)
+	}
+	if mapTmp["replacemsg-group"] != nil {
+		output.ReplacemsgGroup = mapTmp["replacemsg-group"].(string)
+	}
+	if mapTmp["scan-mode"] != nil {
+		output.ScanMode = mapTmp["scan-mode"].(string)
+	}
+	if mapTmp["av-virus-log"] != nil {
+		output.AvVirusLog = mapTmp["av-virus-log"].(string)
+	}
+	if mapTmp["extended-log"] != nil {
+		output.ExtendedLog = mapTmp["extended-log"].(string)
+	}
+	if mapTmp["http"] != nil {
+		c := mapTmp["http"].(map[string]interface{})
+		m := &AntivirusProfileProtocol{}
+		if c["av-scan"] != nil {
+			m.AvScan = c["av-scan"].(string)
+		}
+		if c["outbreak-prevention"] != nil {
+			m.OutbreakPrevention = c["outbreak-prevention"].(string)
+		}
+		if c["external-blocklist"] != nil {
+			m.ExternalBlocklist = c["external-blocklist"].(string)
+		}
+		if c["quarantine"] != nil {
+			m.Quarantine = c["quarantine"].(string)
+		}
+		if c["archive-block"] != nil {
+			m.ArchiveBlock = c["archive-block"].(string)
+		}
+		if c["archive-log"] != nil {
+			m.ArchiveLog = c["archive-log"].(string)
+		}
+		if c["emulator"] != nil {
+			m.Emulator = c["emulator"].(string)
+		}
+		output.HTTP = m
+	}
+	if mapTmp["ftp"] != nil {
+		c := mapTmp["ftp"].(map[string]interface{})
+		m := &AntivirusProfileProtocol{}
+		if c["av-scan"] != nil {
+			m.AvScan = c["av-scan"].(string)
+		}
+		if c["outbreak-prevention"] != nil {
+			m.OutbreakPrevention = c["outbreak-prevention"].(string)
+		}
+		if c["external-blocklist"] != nil {
+			m.ExternalBlocklist = c["external-blocklist"].(string)
+		}
+		if c["quarantine"] != nil {
+			m.Quarantine = c["quarantine"].(string)
+		}
+		if c["archive-block"] != nil {
+			m.ArchiveBlock = c["archive-block"].(string)
+		}
+		if c["archive-log"] != nil {
+			m.ArchiveLog = c["archive-log"].(string)
+		}
+		if c["emulator"] != nil {
+			m.Emulator = c["emulator"].(string)
+		}
+		output.Ftp = m
+	}
+	if mapTmp["imap"] != nil {
+		c := mapTmp["imap"].(map[string]interface{})
+		m := &AntivirusProfileProtocol{}
+		if c["av-scan"] != nil {
+			m.AvScan = c["av-scan"].(string)
+		}
+		if c["outbreak-prevention"] != nil {
+			m.OutbreakPrevention = c["outbreak-prevention"].(string)
+		}
+		if c["external-blocklist"] != nil {
+			m.ExternalBlocklist = c["external-blocklist"].(string)
+		}
+		if c["quarantine"] != nil {
+			m.Quarantine = c["quarantine"].(string)
+		}
+		if c["archive-block"] != nil {
+			m.ArchiveBlock = c["archive-block"].(string)
+		}
+		if c["archive-log"] != nil {
+			m.ArchiveLog = c["archive-log"].(string)
+		}
+		if c["emulator"] != nil {
+			m.Emulator = c["emulator"].(string)
+		}
+		output.Imap = m
+	}
+	if mapTmp["pop3"] != nil {
+		c := mapTmp["pop3"].(map[string]interface{})
+		m := &AntivirusProfileProtocol{}
+		if c["av-scan"] != nil {
+			m.AvScan = c["av-scan"].(string)
+		}
+		if c["outbreak-prevention"] != nil {
+			m.OutbreakPrevention = c["outbreak-prevention"].(string)
+		}
+		if c["external-blocklist"] != nil {
+			m.ExternalBlocklist = c["external-blocklist"].(string)
+		}
+		if c["quarantine"] != nil {
+			m.Quarantine = c["quarantine"].(string)
+		}
+		if c["archive-block"] != nil {
+			m.ArchiveBlock = c["archive-block"].(string)
+		}
+		if c["archive-log"] != nil {
+			m.ArchiveLog = c["archive-log"].(string)
+		}
+		if c["emulator"] != nil {
+			m.Emulator = c["emulator"].(string)
+		}
+		output.Pop3 = m
+	}
+	if mapTmp["smtp"] != nil {
+		c := mapTmp["smtp"].(map[string]interface{})
+		m := &AntivirusProfileProtocol{}
+		if c["av-scan"] != nil {
+			m.AvScan = c["av-scan"].(string)
+		}
+		if c["outbreak-prevention"] != nil {
+			m.OutbreakPrevention = c["outbreak-prevention"].(string)
+		}
+		if c["external-blocklist"] != nil {
+			m.ExternalBlocklist = c["external-blocklist"].(string)
+		}
+		if c["quarantine"] != nil {
+			m.Quarantine = c["quarantine"].(string)
+		}
+		if c["archive-block"] != nil {
+			m.ArchiveBlock = c["archive-block"].(string)
+		}
+		if c["archive-log"] != nil {
+			m.ArchiveLog = c["archive-log"].(string)
+		}
+		if c["emulator"] != nil {
+			m.Emulator = c["emulator"].(string)
+		}
+		output.Smtp = m
+	}
+	if mapTmp["mapi"] != nil {
+		c := mapTmp["mapi"].(map[string]interface{})
+		m := &AntivirusProfileProtocol{}
+		if c["av-scan"] != nil {
+			m.AvScan = c["av-scan"].(string)
+		}
+		if c["outbreak-prevention"] != nil {
+			m.OutbreakPrevention = c["outbreak-prevention"].(string)
+		}
+		if c["external-blocklist"] != nil {
+			m.ExternalBlocklist = c["external-blocklist"].(string)
+		}
+		if c["quarantine"] != nil {
+			m.Quarantine = c["quarantine"].(string)
+		}
+		if c["archive-block"] != nil {
+			m.ArchiveBlock = c["archive-block"].(string)
+		}
+		if c["archive-log"] != nil {
+			m.ArchiveLog = c["archive-log"].(string)
+		}
+		if c["emulator"] != nil {
+			m.Emulator = c["emulator"].(string)
+		}
+		output.Mapi = m
+	}
+	if mapTmp["nntp"] != nil {
+		c := mapTmp["nntp"].(map[string]interface{})
+		m := &AntivirusProfileProtocol{}
+		if c["av-scan"] != nil {
+			m.AvScan = c["av-scan"].(string)
+		}
+		if c["outbreak-prevention"] != nil {
+			m.OutbreakPrevention = c["outbreak-prevention"].(string)
+		}
+		if c["external-blocklist"] != nil {
+			m.ExternalBlocklist = c["external-blocklist"].(string)
+		}
+		if c["quarantine"] != nil {
+			m.Quarantine = c["quarantine"].(string)
+		}
+		if c["archive-block"] != nil {
+			m.ArchiveBlock = c["archive-block"].(string)
+		}
+		if c["archive-log"] != nil {
+			m.ArchiveLog = c["archive-log"].(string)
+		}
+		if c["emulator"] != nil {
+			m.Emulator = c["emulator"].(string)
+		}
+		output.Nntp = m
+	}
+	if mapTmp["cifs"] != nil {
+		c := mapTmp["cifs"].(map[string]interface{})
+		m := &AntivirusProfileProtocol{}
+		if c["av-scan"] != nil {
+			m.AvScan = c["av-scan"].(string)
+		}
+		if c["outbreak-prevention"] != nil {
+			m.OutbreakPrevention = c["outbreak-prevention"].(string)
+		}
+		if c["external-blocklist"] != nil {
+			m.ExternalBlocklist = c["external-blocklist"].(string)
+		}
+		if c["quarantine"] != nil {
+			m.Quarantine = c["quarantine"].(string)
+		}
+		if c["archive-block"] != nil {
+			m.ArchiveBlock = c["archive-block"].(string)
+		}
+		if c["archive-log"] != nil {
+			m.ArchiveLog = c["archive-log"].(string)
+		}
+		if c["emulator"] != nil {
+			m.Emulator = c["emulator"].(string)
+		}
+		output.Cifs = m
+	}
+	if mapTmp["ssh"] != nil {
+		c := mapTmp["ssh"].(map[string]interface{})
+		m := &AntivirusProfileProtocol{}
+		if c["av-scan"] != nil {
+			m.AvScan = c["av-scan"].(string)
+		}
+		if c["outbreak-prevention"] != nil {
+			m.OutbreakPrevention = c["outbreak-prevention"].(string)
+		}
+		if c["external-blocklist"] != nil {
+			m.ExternalBlocklist = c["external-blocklist"].(string)
+		}
+		if c["quarantine"] != nil {
+			m.Quarantine = c["quarantine"].(string)
+		}
+		if c["archive-block"] != nil {
+			m.ArchiveBlock = c["archive-block"].(string)
+		}
+		if c["archive-log"] != nil {
+			m.ArchiveLog = c["archive-log"].(string)
+		}
+		if c["emulator"] != nil {
+			m.Emulator = c["emulator"].(string)
+		}
+		output.SSH = m
+	}
+}
@@ -0,0 +1,486 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONApplicationList contains the parameters for Create and Update API function
+type JSONApplicationList struct {
+	Name                     string                 `json:"name"`
+	Comment                  string                 `json:"comment"`
+	ReplacemsgGroup          string                 `json:"replacemsg-group"`
+	ExtendedLog              string                 `json:"extended-log"`
+	Options                  string                 `json:"options"`
+	DeepAppInspection        string                 `json:"deep-app-inspection"`
+	OtherApplicationAction   string                 `json:"other-application-action"`
+	OtherApplicationLog      string                 `json:"other-application-log"`
+	UnknownApplicationAction string                 `json:"unknown-application-action"`
+	UnknownApplicationLog    string                 `json:"unknown-application-log"`
+	EnforceDefaultAppPort    string                 `json:"enforce-default-app-port"`
+	Entries                  []ApplicationListEntry `json:"entries"`
+}
+
+// ApplicationListEntry contains the applications selected by an entry of an application control list and their action
+type ApplicationListEntry struct {
+	ID            int                      `json:"id,omitempty"`
+	Category      []ApplicationIDMultValue `json:"category"`
+	Application   []ApplicationIDMultValue `json:"application"`
+	Protocols     string                   `json:"protocols"`
+	Vendor        string                   `json:"vendor"`
+	Technology    string                   `json:"technology"`
+	Behavior      string                   `json:"behavior"`
+	Popularity    string                   `json:"popularity"`
+	Action        string                   `json:"action"`
+	Log           string                   `json:"log"`
+	LogPacket     string                   `json:"log-packet"`
+	Quarantine    string                   `json:"quarantine"`
+	SessionTTL    int                      `json:"session-ttl,omitempty"`
+	Shaper        string                   `json:"shaper,omitempty"`
+	ShaperReverse string                   `json:"shaper-reverse,omitempty"`
+}
+
+// ApplicationIDMultValue contains the output results for Read API function
+type ApplicationIDMultValue struct {
+	ID int `json:"id"`
+}
+
+// JSONCreateApplicationListOutput contains the output results for Create API function
+type JSONCreateApplicationListOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateApplicationListOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateApplicationListOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateApplicationList API operation for FortiOS creates a new application control list for firewall policies.
+// Returns the index value of the application control list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the application - list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateApplicationList(params *JSONApplicationList) (output *JSONCreateApplicationListOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/application/list"
+	output = &JSONCreateApplicationListOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateApplicationList API operation for FortiOS updates the specified application control list for firewall policies.
+// Returns the index value of the application control list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the application - list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateApplicationList(params *JSONApplicationList, mkey string) (output *JSONUpdateApplicationListOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/application/list"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateApplicationListOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteApplicationList API operation for FortiOS deletes the specified application control list for firewall policies.
+// Returns error for service API and SDK errors.
+// See the application - list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteApplicationList(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/application/list"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadApplicationList API operation for FortiOS gets the application control list for firewall policies
+// with the specified index value.
+// Returns the requested application control list value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the application - list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadApplicationList(mkey string) (output *JSONApplicationList, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/application/list"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONApplicationList{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillApplicationList(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListApplicationLists API operation for FortiOS gets all the application control lists for firewall policies.
+// Returns the application control lists when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the application - list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListApplicationLists() (output []*JSONApplicationList, err error) {
+	results, err := c.listCmdbTable("application/list")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONApplicationList, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONApplicationList{}
+		fillApplicationList(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillApplicationList fills output from a application control list of the response
+func fillApplicationList(output *JSONApplicationList, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["replacemsg-group"] != nil {
+		output.ReplacemsgGroup = mapTmp["replacemsg-group"].(string)
+	}
+	if mapTmp["extended-log"] != nil {
+		output.ExtendedLog = mapTmp["extended-log"].(string)
+	}
+	if mapTmp["options"] != nil {
+		output.Options = mapTmp["options"].(string)
+	}
+	if mapTmp["deep-app-inspection"] != nil {
+		output.DeepAppInspection = mapTmp["deep-app-inspection"].(string)
+	}
+	if mapTmp["other-application-action"] != nil {
+		output.OtherApplicationAction = mapTmp["other-application-action"].(string)
+	}
+	if mapTmp["other-application-log"] != nil {
+		output.OtherApplicationLog = mapTmp["other-application-log"].(string)
+	}
+	if mapTmp["unknown-application-action"] != nil {
+		output.UnknownApplicationAction = mapTmp["unknown-application-action"].(string)
+	}
+	if mapTmp["unknown-application-log"] != nil {
+		output.UnknownApplicationLog = mapTmp["unknown-application-log"].(string)
+	}
+	if mapTmp["enforce-default-app-port"] != nil {
+		output.EnforceDefaultAppPort = mapTmp["enforce-default-app-port"].(string)
+	}
+	if mapTmp["entries"] != nil {
+		member := mapTmp["entries"].([]interface{})
+
+		var members []ApplicationListEntry
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := ApplicationListEntry{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["category"] != nil {
+				member := c["category"].([]interface{})
+
+				var members []ApplicationIDMultValue
+				for _, v := range member {
+					c := v.(map[string]interface{})
+					m := ApplicationIDMultValue{}
+					if c["id"] != nil {
+						m.ID = int(c["id"].(float64))
+					}
+					members = append(members, m)
+				}
+				m.Category = members
+			}
+			if c["application"] != nil {
+				member := c["application"].([]interface{})
+
+				var members []ApplicationIDMultValue
+				for _, v := range member {
+					c := v.(map[string]interface{})
+					m := ApplicationIDMultValue{}
+					if c["id"] != nil {
+						m.ID = int(c["id"].(float64))
+					}
+					members = append(members, m)
+				}
+				m.Application = members
+			}
+			if c["protocols"] != nil {
+				m.Protocols = c["protocols"].(string)
+			}
+			if c["vendor"] != nil {
+				m.Vendor = c["vendor"].(string)
+			}
+			if c["technology"] != nil {
+				m.Technology = c["technology"].(string)
+			}
+			if c["behavior"] != nil {
+				m.Behavior = c["behavior"].(string)
+			}
+			if c["popularity"] != nil {
+				m.Popularity = c["popularity"].(string)
+			}
+			if c["action"] != nil {
+				m.Action = c["action"].(string)
+			}
+			if c["log"] != nil {
+				m.Log = c["log"].(string)
+			}
+			if c["log-packet"] != nil {
+				m.LogPacket = c["log-packet"].(string)
+			}
+			if c["quarantine"] != nil {
+				m.Quarantine = c["quarantine"].(string)
+			}
+			if c["session-ttl"] != nil {
+				m.SessionTTL = int(c["session-ttl"].(float64))
+			}
+			if c["shaper"] != nil {
+				m.Shaper = c["shaper"].(string)
+			}
+			if c["shaper-reverse"] != nil {
+				m.ShaperReverse = c["shaper-reverse"].(string)
+			}
+			members = append(members, m)
+		}
+		output.Entries = members
+	}
+}
@@ -0,0 +1,240 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/fgtdev/fortios-sdk-go/request"
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// The functions of this file access any cmdb table without a typed structure.
+// table is the cmdb path of the table, such as "firewall/address" or "firewall.service/custom".
+
+// cmdbPath returns the URL path of a cmdb table or of one of its objects
+func cmdbPath(table string, mkey string) string {
+	path := "/api/v2/cmdb/" + table
+	if mkey != "" {
+		path += "/" + EscapeURLString(mkey)
+	}
+	return path
+}
+
+// splitTable splits a cmdb table into its path and name, such as "firewall.service" and "custom"
+func splitTable(table string) (path string, name string, err error) {
+	i := strings.LastIndex(table, "/")
+	if i <= 0 || i == len(table)-1 {
+		err = fmt.Errorf("invalid table %q, expecting <path>/<name>", table)
+		return
+	}
+	return table[:i], table[i+1:], nil
+}
+
+// mkeyString formats a mkey returned by FortiOS, which is a number for the tables keyed by id
+func mkeyString(v interface{}) string {
+	switch k := v.(type) {
+	case string:
+		return k
+	case float64:
+		return strconv.FormatFloat(k, 'f', -1, 64)
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// sendCmdbRequest sends a request with data encoded as JSON and returns the decoded response.
+// Returns error when the response status is not success.
+func (c *FortiSDKClient) sendCmdbRequest(HTTPMethod string, path string, data interface{}) (result map[string]interface{}, err error) {
+	req, err := c.newJSONRequest(HTTPMethod, path, data)
+	if err != nil {
+		return
+	}
+
+	return c.sendRequest(req)
+}
+
+// newJSONRequest creates a request with data encoded as JSON, data can be nil
+func (c *FortiSDKClient) newJSONRequest(HTTPMethod string, path string, data interface{}) (req *request.Request, err error) {
+	if data == nil {
+		req = c.NewRequest(HTTPMethod, path, nil, nil)
+		return
+	}
+
+	locJSON, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+
+	req = c.NewRequest(HTTPMethod, path, nil, bytes.NewBuffer(locJSON))
+	return
+}
+
+// sendRequest sends the request and returns the decoded response.
+// Returns error when the response status is not success.
+func (c *FortiSDKClient) sendRequest(req *request.Request) (result map[string]interface{}, err error) {
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result == nil {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	if result["status"] == nil {
+		err = fmt.Errorf("cannot get status from the response")
+		return
+	}
+
+	if result["status"] != "success" {
+		if result["error"] != nil {
+			err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+		} else {
+			err = fmt.Errorf("status is %s and error no is not found", result["status"])
+		}
+
+		if result["http_status"] != nil {
+			err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+		} else {
+			err = fmt.Errorf("%s, and http_status no is not found", err)
+		}
+
+		return
+	}
+
+	return
+}
+
+// listCmdbTable returns all the objects of a cmdb table
+func (c *FortiSDKClient) listCmdbTable(table string) (output []map[string]interface{}, err error) {
+	result, err := c.sendCmdbRequest("GET", cmdbPath(table, ""), nil)
+	if err != nil {
+		return
+	}
+
+	return cmdbResults(result)
+}
+
+// listCmdbTableIfExists returns all the objects of a cmdb table, with exists false
+// when the firmware does not have the table, such as firewall/policy46 on FortiOS 7.0
+func (c *FortiSDKClient) listCmdbTableIfExists(table string) (output []map[string]interface{}, exists bool, err error) {
+	result, err := c.sendCmdbRequest("GET", cmdbPath(table, ""), nil)
+	if err != nil {
+		if result != nil && result["http_status"] == 404.0 {
+			err = nil
+		}
+		return
+	}
+
+	output, err = cmdbResults(result)
+	exists = err == nil
+	return
+}
+
+// cmdbResults returns the objects of the results of a table response
+func cmdbResults(result map[string]interface{}) (output []map[string]interface{}, err error) {
+	if result["results"] == nil {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	for _, v := range result["results"].([]interface{}) {
+		if m, ok := v.(map[string]interface{}); ok {
+			output = append(output, m)
+		}
+	}
+
+	return
+}
+
+// readCmdbObject returns the object of a cmdb table with the specified mkey,
+// or nil when it does not exist
+func (c *FortiSDKClient) readCmdbObject(table string, mkey string) (output map[string]interface{}, err error) {
+	result, err := c.sendCmdbRequest("GET", cmdbPath(table, mkey), nil)
+	if err != nil {
+		if result != nil && result["http_status"] == 404.0 {
+			err = nil
+		}
+		return
+	}
+
+	if result["results"] == nil {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	results := result["results"].([]interface{})
+	if len(results) == 0 {
+		return
+	}
+
+	output, _ = results[0].(map[string]interface{})
+	if output == nil {
+		err = fmt.Errorf("cannot get the results from the response")
+	}
+
+	return
+}
+
+// updateCmdbObject updates only the given attributes of the object of a cmdb table with the specified mkey
+func (c *FortiSDKClient) updateCmdbObject(table string, mkey string, attrs map[string]interface{}) (err error) {
+	_, err = c.sendCmdbRequest("PUT", cmdbPath(table, mkey), attrs)
+	return
+}
+
+// deleteCmdbObject deletes the object of a cmdb table with the specified mkey
+func (c *FortiSDKClient) deleteCmdbObject(table string, mkey string) (err error) {
+	_, err = c.sendCmdbRequest("DELETE", cmdbPath(table, mkey), nil)
+	return
+}
+
+// memberNames returns the names of a member list attribute, such as the srcaddr of a policy,
+// or the name of a single object attribute, such as the schedule of a policy
+func memberNames(v interface{}) []string {
+	if s, ok := v.(string); ok {
+		return []string{s}
+	}
+
+	list, _ := v.([]interface{})
+
+	names := make([]string, 0, len(list))
+	for _, m := range list {
+		e, _ := m.(map[string]interface{})
+		if e == nil {
+			continue
+		}
+		if n, ok := e["name"].(string); ok {
+			names = append(names, n)
+		}
+	}
+
+	return names
+}
+
+// memberList builds a member list attribute from names
+func memberList(names []string) []MultValue {
+	members := make([]MultValue, 0, len(names))
+	for _, n := range names {
+		members = append(members, MultValue{Name: n})
+	}
+	return members
+}
@@ -0,0 +1,79 @@
+package forticlient
+
+import (
+	"fmt"
+)
+
+// StartTransaction API operation for FortiOS starts a cmdb transaction.
+// The changes sent through the returned client are applied together by CommitTransaction,
+// or discarded by AbortTransaction or when timeout seconds elapse.
+// Transactions are supported from FortiOS 6.4.
+// Returns the client bound to the transaction when the request executes successfully.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) StartTransaction(timeout int) (output *FortiSDKClient, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb"
+
+	data := map[string]interface{}{
+		"timeout": timeout,
+	}
+
+	req, err := c.newJSONRequest(HTTPMethod, path, data)
+	if err != nil {
+		return
+	}
+	req.FillUrlParam("action", "transaction-start")
+
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	mapTmp, _ := result["results"].(map[string]interface{})
+	if mapTmp == nil || mapTmp["transaction-id"] == nil {
+		err = fmt.Errorf("cannot get transaction-id from the response")
+		return
+	}
+
+	id, ok := mapTmp["transaction-id"].(float64)
+	if !ok || id == 0 {
+		err = fmt.Errorf("cannot get transaction-id from the response")
+		return
+	}
+
+	tc := *c
+	tc.Config.TransactionID = int(id)
+	output = &tc
+
+	return
+}
+
+// CommitTransaction API operation for FortiOS applies the changes of the transaction the client is bound to.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) CommitTransaction() (err error) {
+	return c.endTransaction("transaction-commit")
+}
+
+// AbortTransaction API operation for FortiOS discards the changes of the transaction the client is bound to.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) AbortTransaction() (err error) {
+	return c.endTransaction("transaction-abort")
+}
+
+// endTransaction commits or aborts the transaction of the client
+func (c *FortiSDKClient) endTransaction(action string) (err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb"
+
+	if c.Config.TransactionID == 0 {
+		err = fmt.Errorf("the client is not bound to a transaction")
+		return
+	}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	req.FillUrlParam("action", action)
+
+	_, err = c.sendRequest(req)
+
+	return
+}
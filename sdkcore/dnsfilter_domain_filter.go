@@ -0,0 +1,388 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONDnsfilterDomainFilter contains the parameters for Create and Update API function
+type JSONDnsfilterDomainFilter struct {
+	ID      int                          `json:"id,omitempty"`
+	Name    string                       `json:"name"`
+	Comment string                       `json:"comment"`
+	Entries []DnsfilterDomainFilterEntry `json:"entries"`
+}
+
+// DnsfilterDomainFilterEntry contains a domain of a DNS filter domain list
+// Type is "simple", "regex" or "wildcard", Action is "block", "allow" or "monitor".
+type DnsfilterDomainFilterEntry struct {
+	ID     int    `json:"id,omitempty"`
+	Domain string `json:"domain"`
+	Type   string `json:"type"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+}
+
+// JSONCreateDnsfilterDomainFilterOutput contains the output results for Create API function
+type JSONCreateDnsfilterDomainFilterOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateDnsfilterDomainFilterOutput contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateDnsfilterDomainFilterOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateDnsfilterDomainFilter API operation for FortiOS creates a new DNS filter domain list for DNS filter profiles.
+// Returns the index value of the DNS filter domain list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - domain-filter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateDnsfilterDomainFilter(params *JSONDnsfilterDomainFilter) (output *JSONCreateDnsfilterDomainFilterOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/dnsfilter/domain-filter"
+	output = &JSONCreateDnsfilterDomainFilterOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateDnsfilterDomainFilter API operation for FortiOS updates the specified DNS filter domain list for DNS filter profiles.
+// Returns the index value of the DNS filter domain list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - domain-filter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateDnsfilterDomainFilter(params *JSONDnsfilterDomainFilter, mkey string) (output *JSONUpdateDnsfilterDomainFilterOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/dnsfilter/domain-filter"
+	path += "/" + mkey
+	output = &JSONUpdateDnsfilterDomainFilterOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteDnsfilterDomainFilter API operation for FortiOS deletes the specified DNS filter domain list for DNS filter profiles.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - domain-filter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteDnsfilterDomainFilter(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/dnsfilter/domain-filter"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadDnsfilterDomainFilter API operation for FortiOS gets the DNS filter domain list for DNS filter profiles
+// with the specified index value.
+// Returns the requested DNS filter domain list value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - domain-filter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadDnsfilterDomainFilter(mkey string) (output *JSONDnsfilterDomainFilter, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/dnsfilter/domain-filter"
+	path += "/" + mkey
+
+	output = &JSONDnsfilterDomainFilter{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillDnsfilterDomainFilter(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListDnsfilterDomainFilters API operation for FortiOS gets all the DNS filter domain lists for DNS filter profiles.
+// Returns the DNS filter domain lists when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - domain-filter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListDnsfilterDomainFilters() (output []*JSONDnsfilterDomainFilter, err error) {
+	results, err := c.listCmdbTable("dnsfilter/domain-filter")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONDnsfilterDomainFilter, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONDnsfilterDomainFilter{}
+		fillDnsfilterDomainFilter(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillDnsfilterDomainFilter fills output from a DNS filter domain list of the response
+func fillDnsfilterDomainFilter(output *JSONDnsfilterDomainFilter, mapTmp map[string]interface{}) {
+	if mapTmp["id"] != nil {
+		output.ID = int(mapTmp["id"].(float64))
+	}
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["entries"] != nil {
+		member := mapTmp["entries"].([]interface{})
+
+		var members []DnsfilterDomainFilterEntry
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := DnsfilterDomainFilterEntry{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["domain"] != nil {
+				m.Domain = c["domain"].(string)
+			}
+			if c["type"] != nil {
+				m.Type = c["type"].(string)
+			}
+			if c["action"] != nil {
+				m.Action = c["action"].(string)
+			}
+			if c["status"] != nil {
+				m.Status = c["status"].(string)
+			}
+			members = append(members, m)
+		}
+		output.Entries = members
+	}
+}
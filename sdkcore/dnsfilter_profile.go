@@ -0,0 +1,458 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONDnsfilterProfile contains the parameters for Create and Update API function
+type JSONDnsfilterProfile struct {
+	Name                string                        `json:"name"`
+	Comment             string                        `json:"comment"`
+	LogAllDomain        string                        `json:"log-all-domain"`
+	SdnsFtgdErrLog      string                        `json:"sdns-ftgd-err-log"`
+	SdnsDomainLog       string                        `json:"sdns-domain-log"`
+	BlockAction         string                        `json:"block-action"`
+	RedirectPortal      string                        `json:"redirect-portal"`
+	RedirectPortal6     string                        `json:"redirect-portal6"`
+	BlockBotnet         string                        `json:"block-botnet"`
+	SafeSearch          string                        `json:"safe-search"`
+	YoutubeRestrict     string                        `json:"youtube-restrict"`
+	ExternalIPBlocklist MultValues                    `json:"external-ip-blocklist"`
+	DomainFilter        *DnsfilterProfileDomainFilter `json:"domain-filter,omitempty"`
+	FtgdDNS             *DnsfilterProfileFtgdDNS      `json:"ftgd-dns,omitempty"`
+}
+
+// DnsfilterProfileDomainFilter contains the static domain filter of a DNS filter profile
+type DnsfilterProfileDomainFilter struct {
+	DomainFilterTable int `json:"domain-filter-table"`
+}
+
+// DnsfilterProfileFtgdDNS contains the FortiGuard category filtering parameters of a DNS filter profile
+type DnsfilterProfileFtgdDNS struct {
+	Options string                          `json:"options,omitempty"`
+	Filters []DnsfilterProfileFtgdDNSFilter `json:"filters,omitempty"`
+}
+
+// DnsfilterProfileFtgdDNSFilter contains the action of a FortiGuard category of a DNS filter profile
+type DnsfilterProfileFtgdDNSFilter struct {
+	ID       int    `json:"id,omitempty"`
+	Category int    `json:"category"`
+	Action   string `json:"action"`
+	Log      string `json:"log"`
+}
+
+// JSONCreateDnsfilterProfileOutput contains the output results for Create API function
+type JSONCreateDnsfilterProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateDnsfilterProfileOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateDnsfilterProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateDnsfilterProfile API operation for FortiOS creates a new DNS filter profile for firewall policies.
+// Returns the index value of the DNS filter profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateDnsfilterProfile(params *JSONDnsfilterProfile) (output *JSONCreateDnsfilterProfileOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/dnsfilter/profile"
+	output = &JSONCreateDnsfilterProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateDnsfilterProfile API operation for FortiOS updates the specified DNS filter profile for firewall policies.
+// Returns the index value of the DNS filter profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateDnsfilterProfile(params *JSONDnsfilterProfile, mkey string) (output *JSONUpdateDnsfilterProfileOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/dnsfilter/profile"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateDnsfilterProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteDnsfilterProfile API operation for FortiOS deletes the specified DNS filter profile for firewall policies.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteDnsfilterProfile(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/dnsfilter/profile"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadDnsfilterProfile API operation for FortiOS gets the DNS filter profile for firewall policies
+// with the specified index value.
+// Returns the requested DNS filter profile value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadDnsfilterProfile(mkey string) (output *JSONDnsfilterProfile, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/dnsfilter/profile"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONDnsfilterProfile{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillDnsfilterProfile(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListDnsfilterProfiles API operation for FortiOS gets all the DNS filter profiles for firewall policies.
+// Returns the DNS filter profiles when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListDnsfilterProfiles() (output []*JSONDnsfilterProfile, err error) {
+	results, err := c.listCmdbTable("dnsfilter/profile")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONDnsfilterProfile, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONDnsfilterProfile{}
+		fillDnsfilterProfile(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillDnsfilterProfile fills output from a DNS filter profile of the response
+func fillDnsfilterProfile(output *JSONDnsfilterProfile, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["log-all-domain"] != nil {
+		output.LogAllDomain = mapTmp["log-all-domain"].(string)
+	}
+	if mapTmp["sdns-ftgd-err-log"] != nil {
+		output.SdnsFtgdErrLog = mapTmp["sdns-ftgd-err-log"].(string)
+	}
+	if mapTmp["sdns-domain-log"] != nil {
+		output.SdnsDomainLog = mapTmp["sdns-domain-log"].(string)
+	}
+	if mapTmp["block-action"] != nil {
+		output.BlockAction = mapTmp["block-action"].(string)
+	}
+	if mapTmp["redirect-portal"] != nil {
+		output.RedirectPortal = mapTmp["redirect-portal"].(string)
+	}
+	if mapTmp["redirect-portal6"] != nil {
+		output.RedirectPortal6 = mapTmp["redirect-portal6"].(string)
+	}
+	if mapTmp["block-botnet"] != nil {
+		output.BlockBotnet = mapTmp["block-botnet"].(string)
+	}
+	if mapTmp["safe-search"] != nil {
+		output.SafeSearch = mapTmp["safe-search"].(string)
+	}
+	if mapTmp["youtube-restrict"] != nil {
+		output.YoutubeRestrict = mapTmp["youtube-restrict"].(string)
+	}
+	if mapTmp["external-ip-blocklist"] != nil {
+		member := mapTmp["external-ip-blocklist"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.ExternalIPBlocklist = members
+	}
+	if mapTmp["domain-filter"] != nil {
+		c := mapTmp["domain-filter"].(map[string]interface{})
+		m := &DnsfilterProfileDomainFilter{}
+		if c["domain-filter-table"] != nil {
+			m.DomainFilterTable = int(c["domain-filter-table"].(float64))
+		}
+		output.DomainFilter = m
+	}
+	if mapTmp["ftgd-dns"] != nil {
+		c := mapTmp["ftgd-dns"].(map[string]interface{})
+		m := &DnsfilterProfileFtgdDNS{}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["filters"] != nil {
+			member := c["filters"].([]interface{})
+
+			var members []DnsfilterProfileFtgdDNSFilter
+			for _, v := range member {
+				c := v.(map[string]interface{})
+				m := DnsfilterProfileFtgdDNSFilter{}
+				if c["id"] != nil {
+					m.ID = int(c["id"].(float64))
+				}
+				if c["category"] != nil {
+					m.Category = int(c["category"].(float64))
+				}
+				if c["action"] != nil {
+					m.Action = c["action"].(string)
+				}
+				if c["log"] != nil {
+					m.Log = c["log"].(string)
+				}
+				members = append(members, m)
+			}
+			m.Filters = members
+		}
+		output.FtgdDNS = m
+	}
+}
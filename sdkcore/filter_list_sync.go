@@ -0,0 +1,324 @@
+package forticlient
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// filterSyncBulkThreshold is the number of changes above which a sync replaces the whole
+// entry table in one request, instead of adding, updating and removing the entries one by one
+const filterSyncBulkThreshold = 50
+
+// JSONFilterSyncOutput contains the changes applied by the Sync API functions
+type JSONFilterSyncOutput struct {
+	Added   int
+	Updated int
+	Removed int
+	// Bulk is true when the entry table was replaced in one request
+	Bulk bool
+}
+
+// filterEntry is an entry of an URL or domain list reduced to its identity and its settings
+type filterEntry struct {
+	id       int
+	key      string
+	settings string
+	value    interface{}
+}
+
+// filterDiff contains the changes turning the current entries into the desired ones.
+// The updated entries are the desired values with the id of the current entry.
+type filterDiff struct {
+	add    []filterEntry
+	update []filterEntry
+	remove []filterEntry
+	// entries is the resulting table: the current entries in their order,
+	// followed by the added entries in the desired order
+	entries []filterEntry
+}
+
+// diffFilterEntries computes the minimal changes turning current into desired.
+// The entries are matched on their key, the last desired entry wins when a key is repeated.
+func diffFilterEntries(current []filterEntry, desired []filterEntry) filterDiff {
+	var d filterDiff
+
+	want := make(map[string]int, len(desired))
+	for i, e := range desired {
+		want[e.key] = i
+	}
+
+	seen := make(map[string]bool, len(current))
+	for _, e := range current {
+		i, ok := want[e.key]
+		if !ok || seen[e.key] {
+			d.remove = append(d.remove, e)
+			continue
+		}
+		seen[e.key] = true
+
+		if desired[i].settings == e.settings {
+			d.entries = append(d.entries, e)
+			continue
+		}
+
+		u := desired[i]
+		u.id = e.id
+		d.update = append(d.update, u)
+		d.entries = append(d.entries, u)
+	}
+
+	for i, e := range desired {
+		if seen[e.key] || want[e.key] != i {
+			continue
+		}
+		d.add = append(d.add, e)
+		d.entries = append(d.entries, e)
+	}
+
+	return d
+}
+
+// applyFilterSync applies the changes to the entries sub-table of the object of the table
+func (c *FortiSDKClient) applyFilterSync(table string, mkey string, d filterDiff) (output *JSONFilterSyncOutput, err error) {
+	output = &JSONFilterSyncOutput{
+		Added:   len(d.add),
+		Updated: len(d.update),
+		Removed: len(d.remove),
+	}
+
+	changes := len(d.add) + len(d.update) + len(d.remove)
+	if changes == 0 {
+		return
+	}
+
+	path := cmdbPath(table, mkey)
+
+	if changes > filterSyncBulkThreshold {
+		entries := make([]interface{}, 0, len(d.entries))
+		for _, e := range d.entries {
+			entries = append(entries, e.value)
+		}
+
+		_, err = c.sendCmdbRequest("PUT", path, map[string]interface{}{"entries": entries})
+		if err != nil {
+			err = fmt.Errorf("cannot replace the entries of %s %s: %s", table, mkey, err)
+			return
+		}
+		output.Bulk = true
+		return
+	}
+
+	for _, e := range d.remove {
+		_, err = c.sendCmdbRequest("DELETE", path+"/entries/"+strconv.Itoa(e.id), nil)
+		if err != nil {
+			err = fmt.Errorf("cannot remove entry %s of %s %s: %s", e.key, table, mkey, err)
+			return
+		}
+	}
+
+	for _, e := range d.update {
+		_, err = c.sendCmdbRequest("PUT", path+"/entries/"+strconv.Itoa(e.id), e.value)
+		if err != nil {
+			err = fmt.Errorf("cannot update entry %s of %s %s: %s", e.key, table, mkey, err)
+			return
+		}
+	}
+
+	for _, e := range d.add {
+		_, err = c.sendCmdbRequest("POST", path+"/entries", e.value)
+		if err != nil {
+			err = fmt.Errorf("cannot add entry %s of %s %s: %s", e.key, table, mkey, err)
+			return
+		}
+	}
+
+	return
+}
+
+// urlFilterEntry converts an URL list entry, the unset attributes get the FortiOS defaults.
+// The exempt list is only compared with compareExempt, FortiOS fills it for every entry
+// while the desired entries usually leave it to the default.
+func urlFilterEntry(e WebfilterURLFilterEntry, compareExempt bool) filterEntry {
+	if e.Type == "" {
+		e.Type = "simple"
+	}
+	if e.Action == "" {
+		e.Action = "exempt"
+	}
+	if e.Status == "" {
+		e.Status = "enable"
+	}
+
+	settings := e.Action + " " + e.Status
+	if compareExempt {
+		exempt := strings.Fields(e.Exempt)
+		sort.Strings(exempt)
+		settings += " " + strings.Join(exempt, " ")
+	}
+
+	return filterEntry{
+		id:       e.ID,
+		key:      e.Type + " " + e.URL,
+		settings: settings,
+		value:    e,
+	}
+}
+
+// DiffWebfilterURLFilterEntries computes the minimal changes turning the current entries
+// of an URL list into the desired ones. The entries are identified by their type and URL,
+// the updated entries have the ID of the current entry.
+func DiffWebfilterURLFilterEntries(current []WebfilterURLFilterEntry, desired []WebfilterURLFilterEntry) (add []WebfilterURLFilterEntry, update []WebfilterURLFilterEntry, remove []WebfilterURLFilterEntry) {
+	d := diffURLFilterEntries(current, desired)
+
+	for _, e := range d.add {
+		add = append(add, e.value.(WebfilterURLFilterEntry))
+	}
+	for _, e := range d.update {
+		update = append(update, e.value.(WebfilterURLFilterEntry))
+	}
+	for _, e := range d.remove {
+		remove = append(remove, e.value.(WebfilterURLFilterEntry))
+	}
+
+	return
+}
+
+// diffURLFilterEntries computes the changes of an URL list, the new entries have no ID
+func diffURLFilterEntries(current []WebfilterURLFilterEntry, desired []WebfilterURLFilterEntry) filterDiff {
+	des := make([]filterEntry, 0, len(desired))
+	exempt := make(map[string]bool, len(desired))
+	for _, e := range desired {
+		e.ID = 0
+		d := urlFilterEntry(e, e.Exempt != "")
+		exempt[d.key] = e.Exempt != ""
+		des = append(des, d)
+	}
+
+	cur := make([]filterEntry, 0, len(current))
+	for _, e := range current {
+		cur = append(cur, urlFilterEntry(e, exempt[urlFilterEntry(e, false).key]))
+	}
+
+	d := diffFilterEntries(cur, des)
+	for i := range d.update {
+		v := d.update[i].value.(WebfilterURLFilterEntry)
+		v.ID = d.update[i].id
+		d.update[i].value = v
+	}
+	for i := range d.entries {
+		v := d.entries[i].value.(WebfilterURLFilterEntry)
+		v.ID = d.entries[i].id
+		d.entries[i].value = v
+	}
+
+	return d
+}
+
+// SyncWebfilterURLFilterEntries API operation for FortiOS makes the entries of the web filter
+// URL list with the specified index value match the desired entries, with the minimal changes.
+// The entries are identified by their type and URL. The order of the existing entries is kept
+// and the new entries are appended, use UpdateWebfilterURLFilter when the order matters.
+// Returns the number of changes when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the webfilter - urlfilter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) SyncWebfilterURLFilterEntries(mkey string, desired []WebfilterURLFilterEntry) (output *JSONFilterSyncOutput, err error) {
+	list, err := c.ReadWebfilterURLFilter(mkey)
+	if err != nil {
+		return
+	}
+	if list == nil {
+		err = fmt.Errorf("web filter URL list %s not found", mkey)
+		return
+	}
+
+	return c.applyFilterSync("webfilter/urlfilter", mkey, diffURLFilterEntries(list.Entries, desired))
+}
+
+// domainFilterEntry converts a domain list entry, the unset attributes get the FortiOS defaults
+func domainFilterEntry(e DnsfilterDomainFilterEntry) filterEntry {
+	if e.Type == "" {
+		e.Type = "simple"
+	}
+	if e.Action == "" {
+		e.Action = "block"
+	}
+	if e.Status == "" {
+		e.Status = "enable"
+	}
+
+	return filterEntry{
+		id:       e.ID,
+		key:      e.Type + " " + e.Domain,
+		settings: e.Action + " " + e.Status,
+		value:    e,
+	}
+}
+
+// DiffDnsfilterDomainFilterEntries computes the minimal changes turning the current entries
+// of a domain list into the desired ones. The entries are identified by their type and domain,
+// the updated entries have the ID of the current entry.
+func DiffDnsfilterDomainFilterEntries(current []DnsfilterDomainFilterEntry, desired []DnsfilterDomainFilterEntry) (add []DnsfilterDomainFilterEntry, update []DnsfilterDomainFilterEntry, remove []DnsfilterDomainFilterEntry) {
+	d := diffDomainFilterEntries(current, desired)
+
+	for _, e := range d.add {
+		add = append(add, e.value.(DnsfilterDomainFilterEntry))
+	}
+	for _, e := range d.update {
+		update = append(update, e.value.(DnsfilterDomainFilterEntry))
+	}
+	for _, e := range d.remove {
+		remove = append(remove, e.value.(DnsfilterDomainFilterEntry))
+	}
+
+	return
+}
+
+// diffDomainFilterEntries computes the changes of a domain list, the new entries have no ID
+func diffDomainFilterEntries(current []DnsfilterDomainFilterEntry, desired []DnsfilterDomainFilterEntry) filterDiff {
+	cur := make([]filterEntry, 0, len(current))
+	for _, e := range current {
+		cur = append(cur, domainFilterEntry(e))
+	}
+
+	des := make([]filterEntry, 0, len(desired))
+	for _, e := range desired {
+		e.ID = 0
+		des = append(des, domainFilterEntry(e))
+	}
+
+	d := diffFilterEntries(cur, des)
+	for i := range d.update {
+		v := d.update[i].value.(DnsfilterDomainFilterEntry)
+		v.ID = d.update[i].id
+		d.update[i].value = v
+	}
+	for i := range d.entries {
+		v := d.entries[i].value.(DnsfilterDomainFilterEntry)
+		v.ID = d.entries[i].id
+		d.entries[i].value = v
+	}
+
+	return d
+}
+
+// SyncDnsfilterDomainFilterEntries API operation for FortiOS makes the entries of the DNS filter
+// domain list with the specified index value match the desired entries, with the minimal changes.
+// The entries are identified by their type and domain. The order of the existing entries is kept
+// and the new entries are appended, use UpdateDnsfilterDomainFilter when the order matters.
+// Returns the number of changes when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the dnsfilter - domain-filter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) SyncDnsfilterDomainFilterEntries(mkey string, desired []DnsfilterDomainFilterEntry) (output *JSONFilterSyncOutput, err error) {
+	list, err := c.ReadDnsfilterDomainFilter(mkey)
+	if err != nil {
+		return
+	}
+	if list == nil {
+		err = fmt.Errorf("DNS filter domain list %s not found", mkey)
+		return
+	}
+
+	return c.applyFilterSync("dnsfilter/domain-filter", mkey, diffDomainFilterEntries(list.Entries, desired))
+}
@@ -0,0 +1,203 @@
+package forticlient
+
+import (
+	"reflect"
+	"testing"
+)
+
+// entryKeys returns the keys of the entries
+func entryKeys(entries []filterEntry) []string {
+	keys := []string{}
+	for _, e := range entries {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+// entryIDs returns the ids of the entries
+func entryIDs(entries []filterEntry) []int {
+	ids := []int{}
+	for _, e := range entries {
+		ids = append(ids, e.id)
+	}
+	return ids
+}
+
+func TestDiffFilterEntries(t *testing.T) {
+	e := func(id int, key string, settings string) filterEntry {
+		return filterEntry{id: id, key: key, settings: settings}
+	}
+
+	tests := []struct {
+		name    string
+		current []filterEntry
+		desired []filterEntry
+		add     []string
+		update  []string
+		remove  []string
+		entries []string
+	}{
+		{
+			name:    "empty",
+			add:     []string{},
+			update:  []string{},
+			remove:  []string{},
+			entries: []string{},
+		},
+		{
+			name:    "unchanged",
+			current: []filterEntry{e(1, "a", "x"), e(2, "b", "x")},
+			desired: []filterEntry{e(0, "b", "x"), e(0, "a", "x")},
+			add:     []string{},
+			update:  []string{},
+			remove:  []string{},
+			entries: []string{"a", "b"},
+		},
+		{
+			name:    "add update remove",
+			current: []filterEntry{e(1, "a", "x"), e(2, "b", "x"), e(3, "c", "x")},
+			desired: []filterEntry{e(0, "d", "x"), e(0, "b", "y"), e(0, "a", "x")},
+			add:     []string{"d"},
+			update:  []string{"b"},
+			remove:  []string{"c"},
+			entries: []string{"a", "b", "d"},
+		},
+		{
+			name:    "last desired entry wins",
+			current: []filterEntry{e(1, "a", "x")},
+			desired: []filterEntry{e(0, "a", "y"), e(0, "a", "x"), e(0, "b", "x"), e(0, "b", "y")},
+			add:     []string{"b"},
+			update:  []string{},
+			remove:  []string{},
+			entries: []string{"a", "b"},
+		},
+		{
+			name:    "duplicate current entry removed",
+			current: []filterEntry{e(1, "a", "x"), e(2, "a", "x")},
+			desired: []filterEntry{e(0, "a", "x")},
+			add:     []string{},
+			update:  []string{},
+			remove:  []string{"a"},
+			entries: []string{"a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := diffFilterEntries(tt.current, tt.desired)
+
+			if got := entryKeys(d.add); !reflect.DeepEqual(got, tt.add) {
+				t.Errorf("add = %v, want %v", got, tt.add)
+			}
+			if got := entryKeys(d.update); !reflect.DeepEqual(got, tt.update) {
+				t.Errorf("update = %v, want %v", got, tt.update)
+			}
+			if got := entryKeys(d.remove); !reflect.DeepEqual(got, tt.remove) {
+				t.Errorf("remove = %v, want %v", got, tt.remove)
+			}
+			if got := entryKeys(d.entries); !reflect.DeepEqual(got, tt.entries) {
+				t.Errorf("entries = %v, want %v", got, tt.entries)
+			}
+		})
+	}
+}
+
+func TestDiffFilterEntriesKeepsIDs(t *testing.T) {
+	current := []filterEntry{{id: 7, key: "a", settings: "x"}, {id: 9, key: "b", settings: "x"}}
+	desired := []filterEntry{{key: "a", settings: "y"}, {key: "b", settings: "x"}, {key: "c", settings: "x"}}
+
+	d := diffFilterEntries(current, desired)
+
+	if got := entryIDs(d.update); !reflect.DeepEqual(got, []int{7}) {
+		t.Errorf("update ids = %v, want [7]", got)
+	}
+	if got := entryIDs(d.entries); !reflect.DeepEqual(got, []int{7, 9, 0}) {
+		t.Errorf("entries ids = %v, want [7 9 0]", got)
+	}
+}
+
+func TestDiffWebfilterURLFilterEntries(t *testing.T) {
+	current := []WebfilterURLFilterEntry{
+		{ID: 1, URL: "a.example.com", Type: "simple", Action: "exempt", Status: "enable", Exempt: "av web-content dlp"},
+		{ID: 2, URL: "b.example.com", Type: "wildcard", Action: "block", Status: "enable", Exempt: "av web-content dlp"},
+		{ID: 3, URL: "c.example.com", Type: "simple", Action: "block", Status: "enable", Exempt: "av"},
+	}
+
+	tests := []struct {
+		name    string
+		desired []WebfilterURLFilterEntry
+		add     int
+		update  int
+		remove  int
+	}{
+		{
+			name: "defaults and exempt left unset",
+			desired: []WebfilterURLFilterEntry{
+				{URL: "a.example.com"},
+				{URL: "b.example.com", Type: "wildcard", Action: "block"},
+				{URL: "c.example.com", Action: "block"},
+			},
+		},
+		{
+			name: "exempt in another order",
+			desired: []WebfilterURLFilterEntry{
+				{URL: "a.example.com", Exempt: "dlp av web-content"},
+				{URL: "b.example.com", Type: "wildcard", Action: "block"},
+				{URL: "c.example.com", Action: "block", Exempt: "av"},
+			},
+		},
+		{
+			name: "exempt changed",
+			desired: []WebfilterURLFilterEntry{
+				{URL: "a.example.com", Exempt: "av"},
+				{URL: "b.example.com", Type: "wildcard", Action: "block"},
+				{URL: "c.example.com", Action: "block"},
+			},
+			update: 1,
+		},
+		{
+			name: "type is part of the identity",
+			desired: []WebfilterURLFilterEntry{
+				{URL: "a.example.com"},
+				{URL: "b.example.com", Action: "block"},
+				{URL: "c.example.com", Action: "block"},
+			},
+			add:    1,
+			remove: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, update, remove := DiffWebfilterURLFilterEntries(current, tt.desired)
+			if len(add) != tt.add || len(update) != tt.update || len(remove) != tt.remove {
+				t.Errorf("DiffWebfilterURLFilterEntries() = add %v, update %v, remove %v, want %d, %d, %d",
+					add, update, remove, tt.add, tt.update, tt.remove)
+			}
+		})
+	}
+}
+
+func TestDiffDnsfilterDomainFilterEntries(t *testing.T) {
+	current := []DnsfilterDomainFilterEntry{
+		{ID: 4, Domain: "a.example.com", Type: "simple", Action: "block", Status: "enable"},
+		{ID: 5, Domain: "b.example.com", Type: "simple", Action: "allow", Status: "enable"},
+	}
+	desired := []DnsfilterDomainFilterEntry{
+		{Domain: "a.example.com"},
+		{Domain: "b.example.com", Action: "block"},
+		{Domain: "c.example.com"},
+	}
+
+	add, update, remove := DiffDnsfilterDomainFilterEntries(current, desired)
+
+	if len(add) != 1 || add[0].Domain != "c.example.com" || add[0].ID != 0 {
+		t.Errorf("add = %v, want c.example.com without ID", add)
+	}
+	if len(update) != 1 || update[0].Domain != "b.example.com" || update[0].ID != 5 {
+		t.Errorf("update = %v, want b.example.com with ID 5", update)
+	}
+	if len(remove) != 0 {
+		t.Errorf("remove = %v, want none", remove)
+	}
+}
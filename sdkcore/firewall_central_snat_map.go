@@ -0,0 +1,442 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallCentralSnatMap contains the parameters for Create and Update API function
+type JSONFirewallCentralSnatMap struct {
+	Policyid  int        `json:"policyid,omitempty"`
+	Status    string     `json:"status"`
+	Srcintf   MultValues `json:"srcintf"`
+	Dstintf   MultValues `json:"dstintf"`
+	OrigAddr  MultValues `json:"orig-addr"`
+	DstAddr   MultValues `json:"dst-addr"`
+	Protocol  int        `json:"protocol"`
+	OrigPort  string     `json:"orig-port"`
+	Nat       string     `json:"nat"`
+	NatIppool MultValues `json:"nat-ippool"`
+	NatPort   string     `json:"nat-port"`
+	Comments  string     `json:"comments"`
+}
+
+// JSONCreateFirewallCentralSnatMapOutput contains the output results for Create API function
+type JSONCreateFirewallCentralSnatMapOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallCentralSnatMapOutput contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateFirewallCentralSnatMapOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallCentralSnatMap API operation for FortiOS creates a new central SNAT map entry, which translates the source of the traffic when central NAT is enabled.
+// Returns the index value of the central SNAT map entry and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - central-snat-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallCentralSnatMap(params *JSONFirewallCentralSnatMap) (output *JSONCreateFirewallCentralSnatMapOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/central-snat-map"
+	output = &JSONCreateFirewallCentralSnatMapOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallCentralSnatMap API operation for FortiOS updates the specified central SNAT map entry, which translates the source of the traffic when central NAT is enabled.
+// Returns the index value of the central SNAT map entry and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - central-snat-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallCentralSnatMap(params *JSONFirewallCentralSnatMap, mkey string) (output *JSONUpdateFirewallCentralSnatMapOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/central-snat-map"
+	path += "/" + mkey
+	output = &JSONUpdateFirewallCentralSnatMapOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallCentralSnatMap API operation for FortiOS deletes the specified central SNAT map entry, which translates the source of the traffic when central NAT is enabled.
+// Returns error for service API and SDK errors.
+// See the firewall - central-snat-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallCentralSnatMap(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/central-snat-map"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallCentralSnatMap API operation for FortiOS gets the central SNAT map entry, which translates the source of the traffic when central NAT is enabled
+// with the specified index value.
+// Returns the requested central SNAT map entry value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - central-snat-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallCentralSnatMap(mkey string) (output *JSONFirewallCentralSnatMap, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/central-snat-map"
+	path += "/" + mkey
+
+	output = &JSONFirewallCentralSnatMap{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallCentralSnatMap(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallCentralSnatMaps API operation for FortiOS gets all the central SNAT map entries, which translate the source of the traffic when central NAT is enabled.
+// Returns the central SNAT map entries when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - central-snat-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallCentralSnatMaps() (output []*JSONFirewallCentralSnatMap, err error) {
+	results, err := c.listCmdbTable("firewall/central-snat-map")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallCentralSnatMap, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallCentralSnatMap{}
+		fillFirewallCentralSnatMap(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallCentralSnatMap fills output from a central SNAT map entry of the response
+func fillFirewallCentralSnatMap(output *JSONFirewallCentralSnatMap, mapTmp map[string]interface{}) {
+	if mapTmp["policyid"] != nil {
+		output.Policyid = int(mapTmp["policyid"].(float64))
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+	if mapTmp["srcintf"] != nil {
+		member := mapTmp["srcintf"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Srcintf = members
+	}
+	if mapTmp["dstintf"] != nil {
+		member := mapTmp["dstintf"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Dstintf = members
+	}
+	if mapTmp["orig-addr"] != nil {
+		member := mapTmp["orig-addr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.OrigAddr = members
+	}
+	if mapTmp["dst-addr"] != nil {
+		member := mapTmp["dst-addr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.DstAddr = members
+	}
+	if mapTmp["protocol"] != nil {
+		output.Protocol = int(mapTmp["protocol"].(float64))
+	}
+	if mapTmp["orig-port"] != nil {
+		output.OrigPort = mapTmp["orig-port"].(string)
+	}
+	if mapTmp["nat"] != nil {
+		output.Nat = mapTmp["nat"].(string)
+	}
+	if mapTmp["nat-ippool"] != nil {
+		member := mapTmp["nat-ippool"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.NatIppool = members
+	}
+	if mapTmp["nat-port"] != nil {
+		output.NatPort = mapTmp["nat-port"].(string)
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+}
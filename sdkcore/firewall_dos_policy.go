@@ -0,0 +1,441 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallDoSPolicy contains the parameters for Create and Update API function
+type JSONFirewallDoSPolicy struct {
+	Policyid  int                        `json:"policyid,omitempty"`
+	Status    string                     `json:"status"`
+	Name      string                     `json:"name"`
+	Comments  string                     `json:"comments"`
+	Interface string                     `json:"interface"`
+	Srcaddr   MultValues                 `json:"srcaddr"`
+	Dstaddr   MultValues                 `json:"dstaddr"`
+	Service   MultValues                 `json:"service"`
+	Anomaly   []FirewallDoSPolicyAnomaly `json:"anomaly"`
+}
+
+// FirewallDoSPolicyAnomaly contains the settings of an anomaly of a DoS policy, such as "tcp_syn_flood"
+// Action is "pass" or "block", Threshold is in packets or sessions per second.
+type FirewallDoSPolicyAnomaly struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Log       string `json:"log"`
+	Action    string `json:"action"`
+	Threshold int    `json:"threshold"`
+}
+
+// JSONCreateFirewallDoSPolicyOutput contains the output results for Create API function
+type JSONCreateFirewallDoSPolicyOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallDoSPolicyOutput contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateFirewallDoSPolicyOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallDoSPolicy API operation for FortiOS creates a new DoS policy, which protects an interface against traffic anomalies.
+// Returns the index value of the DoS policy and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - DoS-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallDoSPolicy(params *JSONFirewallDoSPolicy) (output *JSONCreateFirewallDoSPolicyOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/DoS-policy"
+	output = &JSONCreateFirewallDoSPolicyOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallDoSPolicy API operation for FortiOS updates the specified DoS policy, which protects an interface against traffic anomalies.
+// Returns the index value of the DoS policy and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - DoS-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallDoSPolicy(params *JSONFirewallDoSPolicy, mkey string) (output *JSONUpdateFirewallDoSPolicyOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/DoS-policy"
+	path += "/" + mkey
+	output = &JSONUpdateFirewallDoSPolicyOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallDoSPolicy API operation for FortiOS deletes the specified DoS policy, which protects an interface against traffic anomalies.
+// Returns error for service API and SDK errors.
+// See the firewall - DoS-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallDoSPolicy(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/DoS-policy"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallDoSPolicy API operation for FortiOS gets the DoS policy, which protects an interface against traffic anomalies
+// with the specified index value.
+// Returns the requested DoS policy value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - DoS-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallDoSPolicy(mkey string) (output *JSONFirewallDoSPolicy, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/DoS-policy"
+	path += "/" + mkey
+
+	output = &JSONFirewallDoSPolicy{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallDoSPolicy(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallDoSPolicies API operation for FortiOS gets all the DoS policies, which protect an interface against traffic anomalies.
+// Returns the DoS policies when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - DoS-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallDoSPolicies() (output []*JSONFirewallDoSPolicy, err error) {
+	results, err := c.listCmdbTable("firewall/DoS-policy")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallDoSPolicy, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallDoSPolicy{}
+		fillFirewallDoSPolicy(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallDoSPolicy fills output from a DoS policy of the response
+func fillFirewallDoSPolicy(output *JSONFirewallDoSPolicy, mapTmp map[string]interface{}) {
+	if mapTmp["policyid"] != nil {
+		output.Policyid = int(mapTmp["policyid"].(float64))
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+	if mapTmp["interface"] != nil {
+		output.Interface = mapTmp["interface"].(string)
+	}
+	if mapTmp["srcaddr"] != nil {
+		member := mapTmp["srcaddr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Srcaddr = members
+	}
+	if mapTmp["dstaddr"] != nil {
+		member := mapTmp["dstaddr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Dstaddr = members
+	}
+	if mapTmp["service"] != nil {
+		member := mapTmp["service"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Service = members
+	}
+	if mapTmp["anomaly"] != nil {
+		member := mapTmp["anomaly"].([]interface{})
+
+		var members []FirewallDoSPolicyAnomaly
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := FirewallDoSPolicyAnomaly{}
+			if c["name"] != nil {
+				m.Name = c["name"].(string)
+			}
+			if c["status"] != nil {
+				m.Status = c["status"].(string)
+			}
+			if c["log"] != nil {
+				m.Log = c["log"].(string)
+			}
+			if c["action"] != nil {
+				m.Action = c["action"].(string)
+			}
+			if c["threshold"] != nil {
+				m.Threshold = int(c["threshold"].(float64))
+			}
+			members = append(members, m)
+		}
+		output.Anomaly = members
+	}
+}
@@ -0,0 +1,150 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallInternetService contains the output results for Read API function
+// The entries are maintained by FortiGuard, Direction is "src", "dst" or "both".
+type JSONFirewallInternetService struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	Reputation    int    `json:"reputation"`
+	IconID        int    `json:"icon-id"`
+	Direction     string `json:"direction"`
+	Database      string `json:"database"`
+	IPRangeNumber int    `json:"ip-range-number"`
+	IPNumber      int    `json:"ip-number"`
+}
+
+// ReadFirewallInternetService API operation for FortiOS gets the Internet Service Database entry
+// with the specified index value.
+// Returns the requested Internet Service Database entry value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - internet-service chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallInternetService(mkey string) (output *JSONFirewallInternetService, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/internet-service"
+	path += "/" + mkey
+
+	output = &JSONFirewallInternetService{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallInternetService(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallInternetServices API operation for FortiOS gets all the Internet Service Database entries.
+// Returns the Internet Service Database entries when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - internet-service chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallInternetServices() (output []*JSONFirewallInternetService, err error) {
+	results, err := c.listCmdbTable("firewall/internet-service")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallInternetService, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallInternetService{}
+		fillFirewallInternetService(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallInternetService fills output from an Internet Service Database entry of the response
+func fillFirewallInternetService(output *JSONFirewallInternetService, mapTmp map[string]interface{}) {
+	if mapTmp["id"] != nil {
+		output.ID = int(mapTmp["id"].(float64))
+	}
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["reputation"] != nil {
+		output.Reputation = int(mapTmp["reputation"].(float64))
+	}
+	if mapTmp["icon-id"] != nil {
+		output.IconID = int(mapTmp["icon-id"].(float64))
+	}
+	if mapTmp["direction"] != nil {
+		output.Direction = mapTmp["direction"].(string)
+	}
+	if mapTmp["database"] != nil {
+		output.Database = mapTmp["database"].(string)
+	}
+	if mapTmp["ip-range-number"] != nil {
+		output.IPRangeNumber = int(mapTmp["ip-range-number"].(float64))
+	}
+	if mapTmp["ip-number"] != nil {
+		output.IPNumber = int(mapTmp["ip-number"].(float64))
+	}
+}
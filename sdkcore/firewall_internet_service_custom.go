@@ -0,0 +1,200 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallInternetServiceCustom contains the output results for Read API function
+type JSONFirewallInternetServiceCustom struct {
+	Name       string                       `json:"name"`
+	ID         int                          `json:"id"`
+	Reputation int                          `json:"reputation"`
+	Comment    string                       `json:"comment"`
+	Entry      []InternetServiceCustomEntry `json:"entry"`
+}
+
+// InternetServiceCustomEntry contains the destinations and ports of a custom Internet Service
+type InternetServiceCustomEntry struct {
+	ID        int                              `json:"id"`
+	Protocol  int                              `json:"protocol"`
+	PortRange []InternetServiceCustomPortRange `json:"port-range"`
+	Dst       MultValues                       `json:"dst"`
+}
+
+// InternetServiceCustomPortRange contains a destination port range of a custom Internet Service
+type InternetServiceCustomPortRange struct {
+	ID        int `json:"id"`
+	StartPort int `json:"start-port"`
+	EndPort   int `json:"end-port"`
+}
+
+// ReadFirewallInternetServiceCustom API operation for FortiOS gets the custom Internet Service
+// with the specified index value.
+// Returns the requested custom Internet Service value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - internet-service-custom chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallInternetServiceCustom(mkey string) (output *JSONFirewallInternetServiceCustom, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/internet-service-custom"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallInternetServiceCustom{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallInternetServiceCustom(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallInternetServiceCustoms API operation for FortiOS gets all the custom Internet Services.
+// Returns the custom Internet Services when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - internet-service-custom chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallInternetServiceCustoms() (output []*JSONFirewallInternetServiceCustom, err error) {
+	results, err := c.listCmdbTable("firewall/internet-service-custom")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallInternetServiceCustom, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallInternetServiceCustom{}
+		fillFirewallInternetServiceCustom(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallInternetServiceCustom fills output from a custom Internet Service of the response
+func fillFirewallInternetServiceCustom(output *JSONFirewallInternetServiceCustom, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["id"] != nil {
+		output.ID = int(mapTmp["id"].(float64))
+	}
+	if mapTmp["reputation"] != nil {
+		output.Reputation = int(mapTmp["reputation"].(float64))
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["entry"] != nil {
+		member := mapTmp["entry"].([]interface{})
+
+		var members []InternetServiceCustomEntry
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := InternetServiceCustomEntry{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["protocol"] != nil {
+				m.Protocol = int(c["protocol"].(float64))
+			}
+			if c["port-range"] != nil {
+				member := c["port-range"].([]interface{})
+
+				var members []InternetServiceCustomPortRange
+				for _, v := range member {
+					c := v.(map[string]interface{})
+					m := InternetServiceCustomPortRange{}
+					if c["id"] != nil {
+						m.ID = int(c["id"].(float64))
+					}
+					if c["start-port"] != nil {
+						m.StartPort = int(c["start-port"].(float64))
+					}
+					if c["end-port"] != nil {
+						m.EndPort = int(c["end-port"].(float64))
+					}
+					members = append(members, m)
+				}
+				m.PortRange = members
+			}
+			if c["dst"] != nil {
+				member := c["dst"].([]interface{})
+
+				var members []MultValue
+				for _, v := range member {
+					c := v.(map[string]interface{})
+
+					members = append(members,
+						MultValue{
+							Name: c["name"].(string),
+						})
+				}
+				m.Dst = members
+			}
+			members = append(members, m)
+		}
+		output.Entry = members
+	}
+}
@@ -0,0 +1,144 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallInternetServiceGroup contains the output results for Read API function
+type JSONFirewallInternetServiceGroup struct {
+	Name      string     `json:"name"`
+	Comment   string     `json:"comment"`
+	Direction string     `json:"direction"`
+	Member    MultValues `json:"member"`
+}
+
+// ReadFirewallInternetServiceGroup API operation for FortiOS gets the Internet Service group
+// with the specified index value.
+// Returns the requested Internet Service group value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - internet-service-group chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallInternetServiceGroup(mkey string) (output *JSONFirewallInternetServiceGroup, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/internet-service-group"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallInternetServiceGroup{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallInternetServiceGroup(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallInternetServiceGroups API operation for FortiOS gets all the Internet Service groups.
+// Returns the Internet Service groups when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - internet-service-group chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallInternetServiceGroups() (output []*JSONFirewallInternetServiceGroup, err error) {
+	results, err := c.listCmdbTable("firewall/internet-service-group")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallInternetServiceGroup, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallInternetServiceGroup{}
+		fillFirewallInternetServiceGroup(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallInternetServiceGroup fills output from an Internet Service group of the response
+func fillFirewallInternetServiceGroup(output *JSONFirewallInternetServiceGroup, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["direction"] != nil {
+		output.Direction = mapTmp["direction"].(string)
+	}
+	if mapTmp["member"] != nil {
+		member := mapTmp["member"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Member = members
+	}
+}
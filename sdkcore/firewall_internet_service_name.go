@@ -0,0 +1,142 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallInternetServiceName contains the output results for Read API function
+// It maps a name to an Internet Service Database entry, optionally restricted to a location.
+type JSONFirewallInternetServiceName struct {
+	Name              string `json:"name"`
+	Type              string `json:"type"`
+	InternetServiceID int    `json:"internet-service-id"`
+	CountryID         int    `json:"country-id"`
+	RegionID          int    `json:"region-id"`
+	CityID            int    `json:"city-id"`
+}
+
+// ReadFirewallInternetServiceName API operation for FortiOS gets the Internet Service name
+// with the specified index value.
+// Returns the requested Internet Service name value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - internet-service-name chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallInternetServiceName(mkey string) (output *JSONFirewallInternetServiceName, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/internet-service-name"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallInternetServiceName{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallInternetServiceName(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallInternetServiceNames API operation for FortiOS gets all the Internet Service names.
+// Returns the Internet Service names when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - internet-service-name chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallInternetServiceNames() (output []*JSONFirewallInternetServiceName, err error) {
+	results, err := c.listCmdbTable("firewall/internet-service-name")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallInternetServiceName, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallInternetServiceName{}
+		fillFirewallInternetServiceName(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallInternetServiceName fills output from an Internet Service name of the response
+func fillFirewallInternetServiceName(output *JSONFirewallInternetServiceName, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["internet-service-id"] != nil {
+		output.InternetServiceID = int(mapTmp["internet-service-id"].(float64))
+	}
+	if mapTmp["country-id"] != nil {
+		output.CountryID = int(mapTmp["country-id"].(float64))
+	}
+	if mapTmp["region-id"] != nil {
+		output.RegionID = int(mapTmp["region-id"].(float64))
+	}
+	if mapTmp["city-id"] != nil {
+		output.CityID = int(mapTmp["city-id"].(float64))
+	}
+}
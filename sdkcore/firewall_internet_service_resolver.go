@@ -0,0 +1,241 @@
+package forticlient
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+)
+
+// internetServiceRetryDelay is the delay before a failed load of the Internet Services is retried
+const internetServiceRetryDelay = 30 * time.Second
+
+// InternetServiceResolver converts the names of the Internet Service Database entries to their
+// IDs and back. The names are matched without case, the custom Internet Services are not included.
+// It loads the entries on first use and keeps them until Reset is called. A failed load returns
+// its error until it is retried, 30 seconds later. It is safe for concurrent use.
+type InternetServiceResolver struct {
+	c *FortiSDKClient
+
+	mu      sync.Mutex
+	loaded  bool
+	err     error
+	retryAt time.Time
+	ids     map[string]int
+	names   map[int]string
+}
+
+// isdbInit protects the creation of the resolver of the clients not created by NewClient
+var isdbInit sync.Mutex
+
+// InternetServices returns the resolver of the client, which is shared by the firewall policy API functions
+func (c *FortiSDKClient) InternetServices() *InternetServiceResolver {
+	isdbInit.Lock()
+	defer isdbInit.Unlock()
+
+	if c.isdb == nil {
+		c.isdb = &InternetServiceResolver{c: c}
+	}
+	return c.isdb
+}
+
+// ID returns the ID of the Internet Service with the specified name.
+// Returns error for an unknown name, and for service API and SDK errors.
+func (r *InternetServiceResolver) ID(name string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.load(); err != nil {
+		return 0, err
+	}
+
+	id, ok := r.ids[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown Internet Service %q", name)
+	}
+	return id, nil
+}
+
+// Name returns the name of the Internet Service with the specified ID.
+// Returns error for an unknown ID, and for service API and SDK errors.
+func (r *InternetServiceResolver) Name(id int) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.load(); err != nil {
+		return "", err
+	}
+
+	name, ok := r.names[id]
+	if !ok {
+		return "", fmt.Errorf("unknown Internet Service ID %d", id)
+	}
+	return name, nil
+}
+
+// Reset drops the cached entries and load error, the next call loads them again.
+// Use it after a FortiGuard update of the Internet Service Database, or to retry a failed load at once.
+func (r *InternetServiceResolver) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.loaded = false
+	r.err = nil
+	r.retryAt = time.Time{}
+	r.ids = nil
+	r.names = nil
+}
+
+// load loads the entries when they are not cached, r.mu must be held.
+// A failed load returns the same error until internetServiceRetryDelay has elapsed.
+func (r *InternetServiceResolver) load() error {
+	if r.loaded {
+		return nil
+	}
+	if r.err != nil && time.Now().Before(r.retryAt) {
+		return r.err
+	}
+
+	ids, names, err := r.fetch()
+	if err != nil {
+		r.err, r.retryAt = err, time.Now().Add(internetServiceRetryDelay)
+		return err
+	}
+
+	r.ids, r.names, r.loaded, r.err = ids, names, true, nil
+	return nil
+}
+
+// fetch reads the entries from the device
+func (r *InternetServiceResolver) fetch() (ids map[string]int, names map[int]string, err error) {
+	ids = make(map[string]int)
+	names = make(map[int]string)
+
+	add := func(id int, name string) {
+		if name == "" {
+			return
+		}
+		if _, ok := names[id]; !ok {
+			names[id] = name
+		}
+		if _, ok := ids[strings.ToLower(name)]; !ok {
+			ids[strings.ToLower(name)] = id
+		}
+	}
+
+	// the database holds thousands of entries, only their id and name are requested
+	req := r.c.NewRequest("GET", cmdbPath("firewall/internet-service", ""), nil, nil)
+	req.FillUrlParam("format", "id|name")
+
+	result, err := r.c.sendRequest(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot load the Internet Service Database: %s", err)
+	}
+	entries, ok := result["results"].([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("cannot get the results from the response")
+	}
+	for _, v := range entries {
+		mapTmp, _ := v.(map[string]interface{})
+		if mapTmp == nil || mapTmp["id"] == nil || mapTmp["name"] == nil {
+			continue
+		}
+		add(int(mapTmp["id"].(float64)), mapTmp["name"].(string))
+	}
+
+	// the names of FortiOS 6.4 and later do not exist on every version
+	aliases, err := r.c.ListFirewallInternetServiceNames()
+	if err != nil {
+		log.Printf("FOS-fortios cannot list the Internet Service names: %s", err)
+	}
+	for _, n := range aliases {
+		add(n.InternetServiceID, n.Name)
+	}
+
+	return ids, names, nil
+}
+
+// resolveInternetServiceIDs returns a copy of the policy where the Internet Services
+// given by name have their ID, or the policy itself when every service has an ID
+func (c *FortiSDKClient) resolveInternetServiceIDs(p *JSONFirewallSecurityPolicy) (*JSONFirewallSecurityPolicy, error) {
+	if !needsInternetServiceIDs(p.InternetServiceID) && !needsInternetServiceIDs(p.InternetServiceSrcID) {
+		return p, nil
+	}
+
+	n := *p
+	var err error
+
+	n.InternetServiceID, err = c.internetServiceIDs(p.InternetServiceID)
+	if err != nil {
+		return nil, err
+	}
+	n.InternetServiceSrcID, err = c.internetServiceIDs(p.InternetServiceSrcID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &n, nil
+}
+
+// needsInternetServiceIDs reports whether a service of the list is given by name only
+func needsInternetServiceIDs(members PolicyInternetIDMultValues) bool {
+	for _, m := range members {
+		if m.ID == 0 && m.Name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// internetServiceIDs returns a copy of the list where the services given by name have their ID
+func (c *FortiSDKClient) internetServiceIDs(members PolicyInternetIDMultValues) (PolicyInternetIDMultValues, error) {
+	if members == nil {
+		return nil, nil
+	}
+
+	out := make(PolicyInternetIDMultValues, 0, len(members))
+	for _, m := range members {
+		if m.ID == 0 && m.Name != "" {
+			id, err := c.InternetServices().ID(m.Name)
+			if err != nil {
+				return nil, err
+			}
+			m.ID = float64(id)
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+// nameInternetServices fills the names of the Internet Services of the policies.
+// The names are informative, a failure to load them is only logged, once per call.
+func (c *FortiSDKClient) nameInternetServices(policies ...*JSONFirewallSecurityPolicy) {
+	used := false
+	for _, p := range policies {
+		if len(p.InternetServiceID) > 0 || len(p.InternetServiceSrcID) > 0 {
+			used = true
+			break
+		}
+	}
+	if !used {
+		return
+	}
+
+	r := c.InternetServices()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.load(); err != nil {
+		log.Printf("FOS-fortios cannot name the Internet Services of the policies: %s", err)
+		return
+	}
+
+	for _, p := range policies {
+		for _, members := range []PolicyInternetIDMultValues{p.InternetServiceID, p.InternetServiceSrcID} {
+			for i := range members {
+				members[i].Name = r.names[int(members[i].ID)]
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+)
+
+func TestInternetServiceResolverRetriesFailure(t *testing.T) {
+	var loads, fail atomic.Int32
+	fail.Store(1)
+
+	device := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reply := map[string]interface{}{"status": "success", "http_status": 200, "results": []interface{}{}}
+		if r.URL.Path == "/api/v2/cmdb/firewall/internet-service" {
+			loads.Add(1)
+			if fail.Load() == 1 {
+				reply = map[string]interface{}{"status": "error", "http_status": 500}
+			} else {
+				reply["results"] = []interface{}{map[string]interface{}{"id": 65646, "name": "Example-Web"}}
+			}
+		}
+		json.NewEncoder(w).Encode(reply)
+	}))
+	defer device.Close()
+
+	u, err := url.Parse(device.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client())
+
+	policies := []*JSONFirewallSecurityPolicy{
+		{Policyid: 1, InternetServiceID: PolicyInternetIDMultValues{{ID: 65646}}},
+		{Policyid: 2, InternetServiceID: PolicyInternetIDMultValues{{ID: 65646}}},
+	}
+	c.nameInternetServices(policies...)
+	c.nameInternetServices(policies...)
+	if _, err := c.InternetServices().ID("Example-Web"); err == nil {
+		t.Error("ID() error = nil, want the load error")
+	}
+	if n := loads.Load(); n != 1 {
+		t.Errorf("failed load requested %d times before the retry delay, want 1", n)
+	}
+
+	// the retry delay has elapsed
+	elapse := func() {
+		r := c.InternetServices()
+		r.mu.Lock()
+		r.retryAt = time.Now()
+		r.mu.Unlock()
+	}
+	elapse()
+	c.nameInternetServices(policies...)
+	if n := loads.Load(); n != 2 {
+		t.Errorf("load requested %d times after the retry delay, want 2", n)
+	}
+
+	fail.Store(0)
+	elapse()
+	c.nameInternetServices(policies...)
+	c.nameInternetServices(policies...)
+	if n := loads.Load(); n != 3 {
+		t.Errorf("load requested %d times after a successful retry, want 3", n)
+	}
+	for _, p := range policies {
+		if name := p.InternetServiceID[0].Name; name != "Example-Web" {
+			t.Errorf("policy %d Internet Service name = %q, want Example-Web", p.Policyid, name)
+		}
+	}
+}
+
+func TestInternetServiceResolverReset(t *testing.T) {
+	var loads atomic.Int32
+
+	device := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reply := map[string]interface{}{"status": "success", "http_status": 200, "results": []interface{}{}}
+		if r.URL.Path == "/api/v2/cmdb/firewall/internet-service" {
+			loads.Add(1)
+			reply = map[string]interface{}{"status": "error", "http_status": 500}
+		}
+		json.NewEncoder(w).Encode(reply)
+	}))
+	defer device.Close()
+
+	u, err := url.Parse(device.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client())
+
+	c.InternetServices().Name(65646)
+	c.InternetServices().Reset()
+	c.InternetServices().Name(65646)
+	if n := loads.Load(); n != 2 {
+		t.Errorf("load requested %d times after Reset, want 2", n)
+	}
+}
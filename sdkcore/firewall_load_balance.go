@@ -0,0 +1,109 @@
+package forticlient
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// loadBalanceStatusCount is the maximum number of virtual servers returned by the load balance monitor
+const loadBalanceStatusCount = 1000
+
+// JSONFirewallLoadBalanceStatus contains the health status of the real servers of a virtual server
+type JSONFirewallLoadBalanceStatus struct {
+	VirtualServer     string
+	VirtualServerIP   string
+	VirtualServerPort int
+	Realservers       []JSONFirewallRealserverStatus
+}
+
+// JSONFirewallRealserverStatus contains the health status of a real server
+// Status is "up", "down" or "unknown" and Mode is "active", "standby" or "disabled".
+type JSONFirewallRealserverStatus struct {
+	IP             string
+	Port           int
+	Status         string
+	Mode           string
+	MonitorEvents  int64
+	ActiveSessions int64
+	RTT            string
+	BytesProcessed int64
+}
+
+// ReadFirewallLoadBalanceStatus API operation for FortiOS gets the health status of the real servers
+// of all the server load balancing virtual IPs.
+// Returns the status of each virtual server when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - vip chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallLoadBalanceStatus() (output []*JSONFirewallLoadBalanceStatus, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/monitor/firewall/load-balance"
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	req.FillUrlParam("count", strconv.Itoa(loadBalanceStatusCount))
+
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	results, ok := result["results"].([]interface{})
+	if !ok {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	output = make([]*JSONFirewallLoadBalanceStatus, 0, len(results))
+	for _, v := range results {
+		mapTmp, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		s := &JSONFirewallLoadBalanceStatus{}
+		if mapTmp["virtual_server_name"] != nil {
+			s.VirtualServer = mapTmp["virtual_server_name"].(string)
+		}
+		if mapTmp["virtual_server_ip"] != nil {
+			s.VirtualServerIP = mapTmp["virtual_server_ip"].(string)
+		}
+		if mapTmp["virtual_server_port"] != nil {
+			s.VirtualServerPort = int(mapTmp["virtual_server_port"].(float64))
+		}
+
+		if mapTmp["list"] != nil {
+			for _, r := range mapTmp["list"].([]interface{}) {
+				c := r.(map[string]interface{})
+				m := JSONFirewallRealserverStatus{}
+				if c["real_server_ip"] != nil {
+					m.IP = c["real_server_ip"].(string)
+				}
+				if c["real_server_port"] != nil {
+					m.Port = int(c["real_server_port"].(float64))
+				}
+				if c["status"] != nil {
+					m.Status = c["status"].(string)
+				}
+				if c["mode"] != nil {
+					m.Mode = c["mode"].(string)
+				}
+				if c["monitor_events"] != nil {
+					m.MonitorEvents = int64(c["monitor_events"].(float64))
+				}
+				if c["active_sessions"] != nil {
+					m.ActiveSessions = int64(c["active_sessions"].(float64))
+				}
+				if c["RTT"] != nil {
+					m.RTT = fmt.Sprint(c["RTT"])
+				}
+				if c["bytes_processed"] != nil {
+					m.BytesProcessed = int64(c["bytes_processed"].(float64))
+				}
+				s.Realservers = append(s.Realservers, m)
+			}
+		}
+
+		output = append(output, s)
+	}
+
+	return
+}
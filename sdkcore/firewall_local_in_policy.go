@@ -0,0 +1,408 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallLocalInPolicy contains the parameters for Create and Update API function
+type JSONFirewallLocalInPolicy struct {
+	Policyid int        `json:"policyid,omitempty"`
+	Intf     string     `json:"intf"`
+	Srcaddr  MultValues `json:"srcaddr"`
+	Dstaddr  MultValues `json:"dstaddr"`
+	Action   string     `json:"action"`
+	Service  MultValues `json:"service"`
+	Schedule string     `json:"schedule"`
+	Status   string     `json:"status"`
+	Comments string     `json:"comments"`
+}
+
+// JSONCreateFirewallLocalInPolicyOutput contains the output results for Create API function
+type JSONCreateFirewallLocalInPolicyOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallLocalInPolicyOutput contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateFirewallLocalInPolicyOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallLocalInPolicy API operation for FortiOS creates a new local-in policy, which controls the traffic to the FortiGate itself.
+// Returns the index value of the local-in policy and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - local-in-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallLocalInPolicy(params *JSONFirewallLocalInPolicy) (output *JSONCreateFirewallLocalInPolicyOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/local-in-policy"
+	output = &JSONCreateFirewallLocalInPolicyOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallLocalInPolicy API operation for FortiOS updates the specified local-in policy, which controls the traffic to the FortiGate itself.
+// Returns the index value of the local-in policy and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - local-in-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallLocalInPolicy(params *JSONFirewallLocalInPolicy, mkey string) (output *JSONUpdateFirewallLocalInPolicyOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/local-in-policy"
+	path += "/" + mkey
+	output = &JSONUpdateFirewallLocalInPolicyOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallLocalInPolicy API operation for FortiOS deletes the specified local-in policy, which controls the traffic to the FortiGate itself.
+// Returns error for service API and SDK errors.
+// See the firewall - local-in-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallLocalInPolicy(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/local-in-policy"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallLocalInPolicy API operation for FortiOS gets the local-in policy, which controls the traffic to the FortiGate itself
+// with the specified index value.
+// Returns the requested local-in policy value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - local-in-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallLocalInPolicy(mkey string) (output *JSONFirewallLocalInPolicy, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/local-in-policy"
+	path += "/" + mkey
+
+	output = &JSONFirewallLocalInPolicy{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallLocalInPolicy(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallLocalInPolicies API operation for FortiOS gets all the local-in policies, which control the traffic to the FortiGate itself.
+// Returns the local-in policies when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - local-in-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallLocalInPolicies() (output []*JSONFirewallLocalInPolicy, err error) {
+	results, err := c.listCmdbTable("firewall/local-in-policy")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallLocalInPolicy, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallLocalInPolicy{}
+		fillFirewallLocalInPolicy(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallLocalInPolicy fills output from a local-in policy of the response
+func fillFirewallLocalInPolicy(output *JSONFirewallLocalInPolicy, mapTmp map[string]interface{}) {
+	if mapTmp["policyid"] != nil {
+		output.Policyid = int(mapTmp["policyid"].(float64))
+	}
+	if mapTmp["intf"] != nil {
+		output.Intf = mapTmp["intf"].(string)
+	}
+	if mapTmp["srcaddr"] != nil {
+		member := mapTmp["srcaddr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Srcaddr = members
+	}
+	if mapTmp["dstaddr"] != nil {
+		member := mapTmp["dstaddr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Dstaddr = members
+	}
+	if mapTmp["action"] != nil {
+		output.Action = mapTmp["action"].(string)
+	}
+	if mapTmp["service"] != nil {
+		member := mapTmp["service"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Service = members
+	}
+	if mapTmp["schedule"] != nil {
+		output.Schedule = mapTmp["schedule"].(string)
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+}
@@ -41,13 +41,79 @@ type JSONFirewallObjectAddressIPMask struct {
 	Subnet string `json:"subnet"`
 }
 
+// JSONFirewallObjectAddressWildcard contains the Wildcard parameters for Create and Update API function
+type JSONFirewallObjectAddressWildcard struct {
+	Wildcard string `json:"wildcard"`
+}
+
+// JSONFirewallObjectAddressWildcardFqdn contains the Wildcard FQDN parameters for Create and Update API function
+type JSONFirewallObjectAddressWildcardFqdn struct {
+	WildcardFqdn string `json:"wildcard-fqdn"`
+}
+
+// JSONFirewallObjectAddressDynamic contains the SDN connector parameters for Create and Update API function
+type JSONFirewallObjectAddressDynamic struct {
+	Sdn         string `json:"sdn"`
+	Filter      string `json:"filter"`
+	SdnAddrType string `json:"sdn-addr-type"`
+}
+
+// JSONFirewallObjectAddressInterfaceSubnet contains the Interface Subnet parameters for Create and Update API function
+type JSONFirewallObjectAddressInterfaceSubnet struct {
+	Interface string `json:"interface"`
+}
+
+// JSONFirewallObjectAddressMac contains the MAC parameters for Create and Update API function
+type JSONFirewallObjectAddressMac struct {
+	Macaddr AddressMacaddrMultValues `json:"macaddr"`
+}
+
+// JSONFirewallObjectAddressRouteTag contains the Route Tag parameters for Create and Update API function
+type JSONFirewallObjectAddressRouteTag struct {
+	RouteTag int `json:"route-tag"`
+}
+
+// JSONFirewallObjectAddressOptions contains the tagging and Security Fabric parameters for Create and Update API function
+type JSONFirewallObjectAddressOptions struct {
+	Tagging      AddressTaggingMultValues `json:"tagging,omitempty"`
+	FabricObject string                   `json:"fabric-object,omitempty"`
+	Color        int                      `json:"color,omitempty"`
+}
+
+// AddressMacaddrMultValue contains the output results for Read API function
+type AddressMacaddrMultValue struct {
+	Macaddr string `json:"macaddr"`
+}
+
+// AddressMacaddrMultValues contains the output results for Read API function
+type AddressMacaddrMultValues []AddressMacaddrMultValue
+
+// AddressTaggingMultValue contains the output results for Read API function
+type AddressTaggingMultValue struct {
+	Name     string     `json:"name"`
+	Category string     `json:"category"`
+	Tags     MultValues `json:"tags"`
+}
+
+// AddressTaggingMultValues contains the output results for Read API function
+type AddressTaggingMultValues []AddressTaggingMultValue
+
 // JSONFirewallObjectAddress contains the parameters for Create and Update API function
+// Read always sets the ipmask, iprange, fqdn and geography parameters, empty for an address of another type,
+// the parameters of the other types are only set for an address of their type and nil otherwise.
 type JSONFirewallObjectAddress struct {
 	*JSONFirewallObjectAddressCommon
 	*JSONFirewallObjectAddressIPRange
 	*JSONFirewallObjectAddressCountry
 	*JSONFirewallObjectAddressFqdn
 	*JSONFirewallObjectAddressIPMask
+	*JSONFirewallObjectAddressWildcard
+	*JSONFirewallObjectAddressWildcardFqdn
+	*JSONFirewallObjectAddressDynamic
+	*JSONFirewallObjectAddressInterfaceSubnet
+	*JSONFirewallObjectAddressMac
+	*JSONFirewallObjectAddressRouteTag
+	*JSONFirewallObjectAddressOptions
 }
 
 // JSONCreateFirewallObjectAddressOutput contains the output results for Create API function
@@ -75,6 +141,12 @@ func (c *FortiSDKClient) CreateFirewallObjectAddress(params *JSONFirewallObjectA
 	HTTPMethod := "POST"
 	path := "/api/v2/cmdb/firewall/address"
 	output = &JSONCreateFirewallObjectAddressOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
@@ -148,6 +220,12 @@ func (c *FortiSDKClient) UpdateFirewallObjectAddress(params *JSONFirewallObjectA
 	path := "/api/v2/cmdb/firewall/address"
 	path += "/" + EscapeURLString(mkey)
 	output = &JSONUpdateFirewallObjectAddressOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
@@ -280,19 +358,7 @@ func (c *FortiSDKClient) ReadFirewallObjectAddress(mkey string) (output *JSONFir
 	path := "/api/v2/cmdb/firewall/address"
 	path += "/" + EscapeURLString(mkey)
 
-	j1 := JSONFirewallObjectAddressCommon{}
-	j2 := JSONFirewallObjectAddressIPRange{}
-	j3 := JSONFirewallObjectAddressCountry{}
-	j4 := JSONFirewallObjectAddressFqdn{}
-	j5 := JSONFirewallObjectAddressIPMask{}
-
-	output = &JSONFirewallObjectAddress{
-		JSONFirewallObjectAddressCommon:  &j1,
-		JSONFirewallObjectAddressIPRange: &j2,
-		JSONFirewallObjectAddressCountry: &j3,
-		JSONFirewallObjectAddressFqdn:    &j4,
-		JSONFirewallObjectAddressIPMask:  &j5,
-	}
+	output = &JSONFirewallObjectAddress{}
 
 	req := c.NewRequest(HTTPMethod, path, nil, nil)
 	err = req.Send()
@@ -352,48 +418,163 @@ func (c *FortiSDKClient) ReadFirewallObjectAddress(mkey string) (output *JSONFir
 			return
 		}
 
-		if mapTmp["name"] != nil {
-			output.Name = mapTmp["name"].(string)
-		}
-		if mapTmp["type"] != nil {
-			output.Type = mapTmp["type"].(string)
-		} else {
-			err = fmt.Errorf("cannot get the right response, type doesn't exist.")
-			return
+		fillFirewallObjectAddress(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallObjectAddresses API operation for FortiOS gets all the firewall addresses for firewall policies.
+// Returns the firewall addresses when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - address chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallObjectAddresses() (output []*JSONFirewallObjectAddress, err error) {
+	results, err := c.listCmdbTable("firewall/address")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallObjectAddress, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallObjectAddress{}
+		fillFirewallObjectAddress(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallObjectAddress fills output from a firewall address of the response,
+// the address type defaults to ipmask when the response does not contain it.
+// The ipmask, iprange, fqdn and geography variants are always set, as they were before
+// the other types were modeled, so that callers reading their fields do not need a nil check.
+// The variants of the other types are only set for an address of their type.
+func fillFirewallObjectAddress(output *JSONFirewallObjectAddress, mapTmp map[string]interface{}) {
+	output.JSONFirewallObjectAddressCommon = &JSONFirewallObjectAddressCommon{Type: "ipmask"}
+	output.JSONFirewallObjectAddressOptions = &JSONFirewallObjectAddressOptions{}
+	output.JSONFirewallObjectAddressIPMask = &JSONFirewallObjectAddressIPMask{}
+	output.JSONFirewallObjectAddressIPRange = &JSONFirewallObjectAddressIPRange{}
+	output.JSONFirewallObjectAddressFqdn = &JSONFirewallObjectAddressFqdn{}
+	output.JSONFirewallObjectAddressCountry = &JSONFirewallObjectAddressCountry{}
+
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["associated-interface"] != nil {
+		output.AssociatedIntf = mapTmp["associated-interface"].(string)
+	}
+	if mapTmp["visibility"] != nil {
+		output.ShowInAddressList = mapTmp["visibility"].(string)
+	}
+	if mapTmp["allow-routing"] != nil {
+		output.AllowRouting = mapTmp["allow-routing"].(string)
+	}
+	if mapTmp["fabric-object"] != nil {
+		output.FabricObject = mapTmp["fabric-object"].(string)
+	}
+	if mapTmp["color"] != nil {
+		output.Color = int(mapTmp["color"].(float64))
+	}
+	if mapTmp["tagging"] != nil {
+		member := mapTmp["tagging"].([]interface{})
+
+		var members []AddressTaggingMultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := AddressTaggingMultValue{}
+			if c["name"] != nil {
+				m.Name = c["name"].(string)
+			}
+			if c["category"] != nil {
+				m.Category = c["category"].(string)
+			}
+			if c["tags"] != nil {
+				for _, t := range c["tags"].([]interface{}) {
+					m.Tags = append(m.Tags, MultValue{
+						Name: t.(map[string]interface{})["name"].(string),
+					})
+				}
+			}
+			members = append(members, m)
 		}
+		output.Tagging = members
+	}
 
+	switch output.Type {
+	case "ipmask":
 		if mapTmp["subnet"] != nil {
 			output.Subnet = mapTmp["subnet"].(string)
 		}
+	case "iprange":
 		if mapTmp["start-ip"] != nil {
 			output.StartIP = mapTmp["start-ip"].(string)
 		}
 		if mapTmp["end-ip"] != nil {
 			output.EndIP = mapTmp["end-ip"].(string)
 		}
+	case "fqdn":
 		if mapTmp["fqdn"] != nil {
 			output.Fqdn = mapTmp["fqdn"].(string)
 		}
+	case "geography":
 		if mapTmp["country"] != nil {
 			output.Country = mapTmp["country"].(string)
 		}
-		if mapTmp["comment"] != nil {
-			output.Comment = mapTmp["comment"].(string)
+	case "wildcard":
+		output.JSONFirewallObjectAddressWildcard = &JSONFirewallObjectAddressWildcard{}
+		if mapTmp["wildcard"] != nil {
+			output.Wildcard = mapTmp["wildcard"].(string)
 		}
-		if mapTmp["associated-interface"] != nil {
-			output.AssociatedIntf = mapTmp["associated-interface"].(string)
+	case "wildcard-fqdn":
+		output.JSONFirewallObjectAddressWildcardFqdn = &JSONFirewallObjectAddressWildcardFqdn{}
+		if mapTmp["wildcard-fqdn"] != nil {
+			output.WildcardFqdn = mapTmp["wildcard-fqdn"].(string)
 		}
-		if mapTmp["visibility"] != nil {
-			output.ShowInAddressList = mapTmp["visibility"].(string)
+	case "dynamic":
+		output.JSONFirewallObjectAddressDynamic = &JSONFirewallObjectAddressDynamic{}
+		if mapTmp["sdn"] != nil {
+			output.Sdn = mapTmp["sdn"].(string)
 		}
-		if mapTmp["allow-routing"] != nil {
-			output.AllowRouting = mapTmp["allow-routing"].(string)
+		if mapTmp["filter"] != nil {
+			output.Filter = mapTmp["filter"].(string)
+		}
+		if mapTmp["sdn-addr-type"] != nil {
+			output.SdnAddrType = mapTmp["sdn-addr-type"].(string)
+		}
+	case "interface-subnet":
+		output.JSONFirewallObjectAddressInterfaceSubnet = &JSONFirewallObjectAddressInterfaceSubnet{}
+		if mapTmp["interface"] != nil {
+			output.Interface = mapTmp["interface"].(string)
+		}
+	case "mac":
+		output.JSONFirewallObjectAddressMac = &JSONFirewallObjectAddressMac{}
+		if mapTmp["macaddr"] != nil {
+			member := mapTmp["macaddr"].([]interface{})
+
+			var members []AddressMacaddrMultValue
+			for _, v := range member {
+				c := v.(map[string]interface{})
+
+				members = append(members,
+					AddressMacaddrMultValue{
+						Macaddr: c["macaddr"].(string),
+					})
+			}
+			output.Macaddr = members
+		}
+	case "route-tag":
+		output.JSONFirewallObjectAddressRouteTag = &JSONFirewallObjectAddressRouteTag{}
+		if mapTmp["route-tag"] != nil {
+			output.RouteTag = int(mapTmp["route-tag"].(float64))
 		}
-
-	} else {
-		err = fmt.Errorf("cannot get the right response")
-		return
 	}
-
-	return
 }
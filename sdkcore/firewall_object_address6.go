@@ -0,0 +1,417 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallObjectAddress6 contains the parameters for Create and Update API function
+type JSONFirewallObjectAddress6 struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	IP6        string `json:"ip6"`
+	StartIP    string `json:"start-ip"`
+	EndIP      string `json:"end-ip"`
+	Fqdn       string `json:"fqdn"`
+	Comment    string `json:"comment"`
+	Visibility string `json:"visibility"`
+}
+
+// JSONCreateFirewallObjectAddress6Output contains the output results for Create API function
+type JSONCreateFirewallObjectAddress6Output struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallObjectAddress6Output contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateFirewallObjectAddress6Output struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallObjectAddress6 API operation for FortiOS creates a new firewall IPv6 address for firewall policies.
+// Returns the index value of the firewall IPv6 address and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - address6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallObjectAddress6(params *JSONFirewallObjectAddress6) (output *JSONCreateFirewallObjectAddress6Output, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/address6"
+	output = &JSONCreateFirewallObjectAddress6Output{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallObjectAddress6 API operation for FortiOS updates the specified firewall IPv6 address for firewall policies.
+// Returns the index value of the firewall IPv6 address and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - address6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallObjectAddress6(params *JSONFirewallObjectAddress6, mkey string) (output *JSONUpdateFirewallObjectAddress6Output, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/address6"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateFirewallObjectAddress6Output{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallObjectAddress6 API operation for FortiOS deletes the specified firewall IPv6 address for firewall policies.
+// Returns error for service API and SDK errors.
+// See the firewall - address6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallObjectAddress6(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/address6"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallObjectAddress6 API operation for FortiOS gets the firewall IPv6 address for firewall policies
+// with the specified index value.
+// Returns the requested firewall IPv6 address value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - address6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallObjectAddress6(mkey string) (output *JSONFirewallObjectAddress6, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/address6"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallObjectAddress6{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallObjectAddress6(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallObjectAddresses6 API operation for FortiOS gets all the firewall IPv6 addresses for firewall policies.
+// Returns the firewall IPv6 addresses when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - address6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallObjectAddresses6() (output []*JSONFirewallObjectAddress6, err error) {
+	results, err := c.listCmdbTable("firewall/address6")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallObjectAddress6, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallObjectAddress6{}
+		fillFirewallObjectAddress6(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallObjectAddress6 fills output from a firewall IPv6 address of the response
+func fillFirewallObjectAddress6(output *JSONFirewallObjectAddress6, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["ip6"] != nil {
+		output.IP6 = mapTmp["ip6"].(string)
+	}
+	if mapTmp["start-ip"] != nil {
+		output.StartIP = mapTmp["start-ip"].(string)
+	}
+	if mapTmp["end-ip"] != nil {
+		output.EndIP = mapTmp["end-ip"].(string)
+	}
+	if mapTmp["fqdn"] != nil {
+		output.Fqdn = mapTmp["fqdn"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["visibility"] != nil {
+		output.Visibility = mapTmp["visibility"].(string)
+	}
+}
+
+// normalize returns a copy of the address with the prefix in the FortiOS notation.
+// Returns error for an invalid prefix or range.
+func (a *JSONFirewallObjectAddress6) normalize() (*JSONFirewallObjectAddress6, error) {
+	n := *a
+
+	if a.IP6 != "" {
+		p, err := ParseSubnet(a.IP6)
+		if err != nil {
+			return nil, err
+		}
+		if !p.Addr().Is6() {
+			return nil, fmt.Errorf("ip6 %q is not an IPv6 prefix", a.IP6)
+		}
+		if p.Masked() != p {
+			return nil, fmt.Errorf("ip6 %q has host bits set, the network is %s", a.IP6, p.Masked())
+		}
+		n.IP6 = FormatSubnet(p)
+	}
+
+	if a.StartIP != "" && a.EndIP != "" {
+		from, to, err := ParseIPRange(a.StartIP, a.EndIP)
+		if err != nil {
+			return nil, err
+		}
+		if !from.Is6() {
+			return nil, fmt.Errorf("start-ip %s is not an IPv6 address", a.StartIP)
+		}
+		n.StartIP = from.String()
+		n.EndIP = to.String()
+	}
+
+	return &n, nil
+}
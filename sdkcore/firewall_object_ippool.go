@@ -11,13 +11,24 @@ import (
 )
 
 // JSONFirewallObjectIPPool contains the parameters for Create and Update API function
+// Type is "overload", "one-to-one", "fixed-port-range" or "port-block-allocation".
+// SourceStartip and SourceEndip are the internal range of a fixed-port-range pool,
+// BlockSize and NumBlocksPerUser are the port blocks of a port-block-allocation pool.
+// Create and Update do not send them for the other types.
 type JSONFirewallObjectIPPool struct {
-	Name     string `json:"name"`
-	Type     string `json:"type"`
-	Startip  string `json:"startip"`
-	Endip    string `json:"endip"`
-	ArpReply string `json:"arp-reply"`
-	Comments string `json:"comments"`
+	Name             string `json:"name"`
+	Type             string `json:"type"`
+	Startip          string `json:"startip"`
+	Endip            string `json:"endip"`
+	SourceStartip    string `json:"source-startip,omitempty"`
+	SourceEndip      string `json:"source-endip,omitempty"`
+	BlockSize        int    `json:"block-size,omitempty"`
+	NumBlocksPerUser int    `json:"num-blocks-per-user,omitempty"`
+	PbaTimeout       int    `json:"pba-timeout,omitempty"`
+	PermitAnyHost    string `json:"permit-any-host,omitempty"`
+	ArpReply         string `json:"arp-reply"`
+	ArpIntf          string `json:"arp-intf,omitempty"`
+	Comments         string `json:"comments"`
 }
 
 // JSONCreateFirewallObjectIPPoolOutput contains the output results for Create API function
@@ -45,6 +56,12 @@ func (c *FortiSDKClient) CreateFirewallObjectIPPool(params *JSONFirewallObjectIP
 	HTTPMethod := "POST"
 	path := "/api/v2/cmdb/firewall/ippool"
 	output = &JSONCreateFirewallObjectIPPoolOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
@@ -118,6 +135,12 @@ func (c *FortiSDKClient) UpdateFirewallObjectIPPool(params *JSONFirewallObjectIP
 	path := "/api/v2/cmdb/firewall/ippool"
 	path += "/" + EscapeURLString(mkey)
 	output = &JSONUpdateFirewallObjectIPPoolOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
@@ -310,24 +333,7 @@ func (c *FortiSDKClient) ReadFirewallObjectIPPool(mkey string) (output *JSONFire
 			return
 		}
 
-		if mapTmp["name"] != nil {
-			output.Name = mapTmp["name"].(string)
-		}
-		if mapTmp["type"] != nil {
-			output.Type = mapTmp["type"].(string)
-		}
-		if mapTmp["startip"] != nil {
-			output.Startip = mapTmp["startip"].(string)
-		}
-		if mapTmp["endip"] != nil {
-			output.Endip = mapTmp["endip"].(string)
-		}
-		if mapTmp["arp-reply"] != nil {
-			output.ArpReply = mapTmp["arp-reply"].(string)
-		}
-		if mapTmp["comments"] != nil {
-			output.Comments = mapTmp["comments"].(string)
-		}
+		fillFirewallObjectIPPool(output, mapTmp)
 
 	} else {
 		err = fmt.Errorf("cannot get the right response")
@@ -336,3 +342,113 @@ func (c *FortiSDKClient) ReadFirewallObjectIPPool(mkey string) (output *JSONFire
 
 	return
 }
+
+// ListFirewallObjectIPPools API operation for FortiOS gets all the IP address pools.
+// Returns the IP address pools when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - ippool chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallObjectIPPools() (output []*JSONFirewallObjectIPPool, err error) {
+	results, err := c.listCmdbTable("firewall/ippool")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallObjectIPPool, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallObjectIPPool{}
+		fillFirewallObjectIPPool(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallObjectIPPool fills output from an IP address pool of the response
+func fillFirewallObjectIPPool(output *JSONFirewallObjectIPPool, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["startip"] != nil {
+		output.Startip = mapTmp["startip"].(string)
+	}
+	if mapTmp["endip"] != nil {
+		output.Endip = mapTmp["endip"].(string)
+	}
+	if mapTmp["source-startip"] != nil {
+		output.SourceStartip = mapTmp["source-startip"].(string)
+	}
+	if mapTmp["source-endip"] != nil {
+		output.SourceEndip = mapTmp["source-endip"].(string)
+	}
+	if mapTmp["block-size"] != nil {
+		output.BlockSize = int(mapTmp["block-size"].(float64))
+	}
+	if mapTmp["num-blocks-per-user"] != nil {
+		output.NumBlocksPerUser = int(mapTmp["num-blocks-per-user"].(float64))
+	}
+	if mapTmp["pba-timeout"] != nil {
+		output.PbaTimeout = int(mapTmp["pba-timeout"].(float64))
+	}
+	if mapTmp["permit-any-host"] != nil {
+		output.PermitAnyHost = mapTmp["permit-any-host"].(string)
+	}
+	if mapTmp["arp-reply"] != nil {
+		output.ArpReply = mapTmp["arp-reply"].(string)
+	}
+	if mapTmp["arp-intf"] != nil {
+		output.ArpIntf = mapTmp["arp-intf"].(string)
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+}
+
+// normalize returns a copy of the IP address pool after checking its ranges
+// and the attributes of its type. The source range and port block attributes
+// of the other types, which Read returns with their defaults, are cleared.
+func (p *JSONFirewallObjectIPPool) normalize() (*JSONFirewallObjectIPPool, error) {
+	n := *p
+
+	if n.Startip != "" || n.Endip != "" {
+		if _, _, err := ParseIPRange(n.Startip, n.Endip); err != nil {
+			return nil, fmt.Errorf("IP pool %s: %s", n.Name, err)
+		}
+	}
+
+	switch n.Type {
+	case "", "overload", "one-to-one":
+		n.clearSourceRange()
+		n.clearPortBlocks()
+	case "fixed-port-range":
+		n.clearPortBlocks()
+		if _, _, err := ParseIPRange(n.SourceStartip, n.SourceEndip); err != nil {
+			return nil, fmt.Errorf("IP pool %s: invalid source range: %s", n.Name, err)
+		}
+	case "port-block-allocation":
+		n.clearSourceRange()
+		if n.BlockSize != 0 && (n.BlockSize < 64 || n.BlockSize > 4096 || n.BlockSize%64 != 0) {
+			return nil, fmt.Errorf("IP pool %s: block size must be a multiple of 64 between 64 and 4096, got %d", n.Name, n.BlockSize)
+		}
+		if n.NumBlocksPerUser < 0 || n.NumBlocksPerUser > 128 {
+			return nil, fmt.Errorf("IP pool %s: number of blocks per user must be between 1 and 128, got %d", n.Name, n.NumBlocksPerUser)
+		}
+	default:
+		return nil, fmt.Errorf("IP pool %s: invalid type %q", n.Name, n.Type)
+	}
+
+	return &n, nil
+}
+
+func (p *JSONFirewallObjectIPPool) clearSourceRange() {
+	p.SourceStartip = ""
+	p.SourceEndip = ""
+}
+
+func (p *JSONFirewallObjectIPPool) clearPortBlocks() {
+	p.BlockSize = 0
+	p.NumBlocksPerUser = 0
+	p.PbaTimeout = 0
+}
@@ -0,0 +1,87 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+)
+
+func TestFirewallObjectIPPoolReadUpdate(t *testing.T) {
+	for _, typ := range []string{"overload", "one-to-one", "fixed-port-range", "port-block-allocation"} {
+		t.Run(typ, func(t *testing.T) {
+			// the pool as returned by the firmware, with the defaults of the other types
+			pool := map[string]interface{}{
+				"name": "pool", "type": typ, "startip": "203.0.113.10", "endip": "203.0.113.20",
+				"source-startip": "0.0.0.0", "source-endip": "0.0.0.0",
+				"block-size": 128.0, "num-blocks-per-user": 8.0, "pba-timeout": 30.0,
+				"permit-any-host": "disable", "arp-reply": "enable", "comments": "",
+			}
+			if typ == "fixed-port-range" {
+				pool["source-startip"], pool["source-endip"] = "10.0.0.1", "10.0.0.100"
+			}
+
+			var sent map[string]interface{}
+			device := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				reply := map[string]interface{}{"status": "success", "http_status": 200, "results": []interface{}{pool}}
+				if r.Method == "PUT" {
+					json.NewDecoder(r.Body).Decode(&sent)
+					reply = map[string]interface{}{"status": "success", "http_status": 200, "mkey": "pool"}
+				}
+				json.NewEncoder(w).Encode(reply)
+			}))
+			defer device.Close()
+			u, _ := url.Parse(device.URL)
+			c := NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client())
+
+			p, err := c.ReadFirewallObjectIPPool("pool")
+			if err != nil {
+				t.Fatalf("ReadFirewallObjectIPPool() error = %v", err)
+			}
+			if _, err := c.UpdateFirewallObjectIPPool(p, "pool"); err != nil {
+				t.Fatalf("UpdateFirewallObjectIPPool() error = %v", err)
+			}
+
+			want := map[string]bool{
+				"source-startip":      typ == "fixed-port-range",
+				"source-endip":        typ == "fixed-port-range",
+				"block-size":          typ == "port-block-allocation",
+				"num-blocks-per-user": typ == "port-block-allocation",
+				"pba-timeout":         typ == "port-block-allocation",
+			}
+			for k, w := range want {
+				if _, ok := sent[k]; ok != w {
+					t.Errorf("%s sent = %v, want %v", k, ok, w)
+				}
+			}
+			if p.BlockSize != 128 {
+				t.Errorf("Update changed the pool read, block size = %d", p.BlockSize)
+			}
+		})
+	}
+}
+
+func TestFirewallObjectIPPoolNormalizeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		pool JSONFirewallObjectIPPool
+	}{
+		{"reversed range", JSONFirewallObjectIPPool{Type: "overload", Startip: "203.0.113.20", Endip: "203.0.113.10"}},
+		{"fixed port range without source range", JSONFirewallObjectIPPool{Type: "fixed-port-range"}},
+		{"reversed source range", JSONFirewallObjectIPPool{Type: "fixed-port-range", SourceStartip: "10.0.0.9", SourceEndip: "10.0.0.1"}},
+		{"block size", JSONFirewallObjectIPPool{Type: "port-block-allocation", BlockSize: 100}},
+		{"blocks per user", JSONFirewallObjectIPPool{Type: "port-block-allocation", NumBlocksPerUser: 129}},
+		{"type", JSONFirewallObjectIPPool{Type: "nat64"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.pool.normalize(); err == nil {
+				t.Error("normalize() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallObjectLdbMonitor contains the parameters for Create and Update API function
+type JSONFirewallObjectLdbMonitor struct {
+	Name             string `json:"name"`
+	Type             string `json:"type"`
+	Interval         int    `json:"interval"`
+	Timeout          int    `json:"timeout"`
+	Retry            int    `json:"retry"`
+	Port             int    `json:"port"`
+	SrcIP            string `json:"src-ip"`
+	HTTPGet          string `json:"http-get"`
+	HTTPMatch        string `json:"http-match"`
+	HTTPMaxRedirects int    `json:"http-max-redirects"`
+	DNSProtocol      string `json:"dns-protocol"`
+	DNSRequestDomain string `json:"dns-request-domain"`
+	DNSMatchIP       string `json:"dns-match-ip"`
+}
+
+// JSONCreateFirewallObjectLdbMonitorOutput contains the output results for Create API function
+type JSONCreateFirewallObjectLdbMonitorOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallObjectLdbMonitorOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateFirewallObjectLdbMonitorOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallObjectLdbMonitor API operation for FortiOS creates a new firewall server load balancing health check monitor.
+// Returns the index value of the firewall server load balancing health check monitor and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - ldb-monitor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallObjectLdbMonitor(params *JSONFirewallObjectLdbMonitor) (output *JSONCreateFirewallObjectLdbMonitorOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/ldb-monitor"
+	output = &JSONCreateFirewallObjectLdbMonitorOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallObjectLdbMonitor API operation for FortiOS updates the specified firewall server load balancing health check monitor.
+// Returns the index value of the firewall server load balancing health check monitor and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - ldb-monitor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallObjectLdbMonitor(params *JSONFirewallObjectLdbMonitor, mkey string) (output *JSONUpdateFirewallObjectLdbMonitorOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/ldb-monitor"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateFirewallObjectLdbMonitorOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallObjectLdbMonitor API operation for FortiOS deletes the specified firewall server load balancing health check monitor.
+// Returns error for service API and SDK errors.
+// See the firewall - ldb-monitor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallObjectLdbMonitor(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/ldb-monitor"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallObjectLdbMonitor API operation for FortiOS gets the firewall server load balancing health check monitor
+// with the specified index value.
+// Returns the requested firewall server load balancing health check monitor value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - ldb-monitor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallObjectLdbMonitor(mkey string) (output *JSONFirewallObjectLdbMonitor, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/ldb-monitor"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallObjectLdbMonitor{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallObjectLdbMonitor(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallObjectLdbMonitors API operation for FortiOS gets all the firewall server load balancing health check monitors.
+// Returns the firewall server load balancing health check monitors when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - ldb-monitor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallObjectLdbMonitors() (output []*JSONFirewallObjectLdbMonitor, err error) {
+	results, err := c.listCmdbTable("firewall/ldb-monitor")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallObjectLdbMonitor, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallObjectLdbMonitor{}
+		fillFirewallObjectLdbMonitor(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallObjectLdbMonitor fills output from a firewall server load balancing health check monitor of the response
+func fillFirewallObjectLdbMonitor(output *JSONFirewallObjectLdbMonitor, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["interval"] != nil {
+		output.Interval = int(mapTmp["interval"].(float64))
+	}
+	if mapTmp["timeout"] != nil {
+		output.Timeout = int(mapTmp["timeout"].(float64))
+	}
+	if mapTmp["retry"] != nil {
+		output.Retry = int(mapTmp["retry"].(float64))
+	}
+	if mapTmp["port"] != nil {
+		output.Port = int(mapTmp["port"].(float64))
+	}
+	if mapTmp["src-ip"] != nil {
+		output.SrcIP = mapTmp["src-ip"].(string)
+	}
+	if mapTmp["http-get"] != nil {
+		output.HTTPGet = mapTmp["http-get"].(string)
+	}
+	if mapTmp["http-match"] != nil {
+		output.HTTPMatch = mapTmp["http-match"].(string)
+	}
+	if mapTmp["http-max-redirects"] != nil {
+		output.HTTPMaxRedirects = int(mapTmp["http-max-redirects"].(float64))
+	}
+	if mapTmp["dns-protocol"] != nil {
+		output.DNSProtocol = mapTmp["dns-protocol"].(string)
+	}
+	if mapTmp["dns-request-domain"] != nil {
+		output.DNSRequestDomain = mapTmp["dns-request-domain"].(string)
+	}
+	if mapTmp["dns-match-ip"] != nil {
+		output.DNSMatchIP = mapTmp["dns-match-ip"].(string)
+	}
+}
@@ -0,0 +1,267 @@
+package forticlient
+
+import (
+	"fmt"
+	"log"
+)
+
+// renameTransactionTimeout is the timeout in seconds of the transaction used by Rename
+const renameTransactionTimeout = 60
+
+// JSONRenameOutput contains the output results for Rename API function
+type JSONRenameOutput struct {
+	Table   string `json:"table"`
+	OldName string `json:"old-name"`
+	NewName string `json:"new-name"`
+	// Transaction is true when the rename was applied inside a cmdb transaction
+	Transaction bool `json:"transaction"`
+	// Recreated is true when FortiOS kept the old object and the SDK had to
+	// move the references to the new object and delete the old one
+	Recreated bool `json:"recreated"`
+	// References lists the objects which now reference the new name
+	References []JSONObjectReference `json:"references"`
+}
+
+// Rename API operation for FortiOS renames the object of the specified table,
+// such as a firewall address, service or virtual IP, and makes every group and policy
+// referencing it point at the new name.
+// The table is the cmdb path of the object, such as "firewall/address".
+// The rename is done inside a cmdb transaction when the device supports it.
+// When FortiOS recreates the object instead of renaming it, which is only visible once
+// the rename is committed, and the references cannot be moved to the new object,
+// the new object is deleted and the references are restored to the old name.
+// Returns what changed when the request executes successfully.
+// Returns error for service API and SDK errors, and when a reference still points at the old name.
+func (c *FortiSDKClient) Rename(table string, oldName string, newName string) (output *JSONRenameOutput, err error) {
+	if oldName == "" || newName == "" || oldName == newName {
+		err = fmt.Errorf("invalid rename of %q to %q", oldName, newName)
+		return
+	}
+
+	o, err := c.readCmdbObject(table, oldName)
+	if err != nil {
+		return
+	}
+	if o == nil {
+		err = fmt.Errorf("%s %s does not exist", table, oldName)
+		return
+	}
+
+	n, err := c.readCmdbObject(table, newName)
+	if err != nil {
+		return
+	}
+	if n != nil {
+		err = fmt.Errorf("%s %s already exists", table, newName)
+		return
+	}
+
+	refs, err := c.WhereUsed(table, oldName)
+	if err != nil {
+		return
+	}
+
+	output = &JSONRenameOutput{
+		Table:   table,
+		OldName: oldName,
+		NewName: newName,
+	}
+
+	// staged writes may not be visible to the reads of the transaction,
+	// the rename is checked once it is committed
+	output.Transaction, err = c.inTransaction(func(tc *FortiSDKClient) error {
+		return tc.updateCmdbObject(table, oldName, map[string]interface{}{
+			"name": newName,
+		})
+	})
+	if err != nil {
+		return
+	}
+
+	output.Recreated, err = c.renameRecreated(table, oldName, newName)
+	if err != nil {
+		return
+	}
+
+	if output.Recreated {
+		var transaction bool
+		transaction, err = c.inTransaction(func(tc *FortiSDKClient) error {
+			return tc.moveReferences(table, oldName, newName, refs)
+		})
+		output.Transaction = output.Transaction && transaction
+		if err != nil {
+			if erru := c.undoRecreatedRename(table, oldName, newName, refs); erru != nil {
+				err = fmt.Errorf("%s, and cannot undo the rename: %s", err, erru)
+			}
+			return
+		}
+	}
+
+	output.References, err = c.verifyRename(oldName, newName, refs)
+
+	return
+}
+
+// inTransaction runs fn inside a cmdb transaction, or with c when the device
+// does not support transactions, and commits the transaction when fn succeeds.
+// Returns true when fn ran inside a transaction.
+func (c *FortiSDKClient) inTransaction(fn func(tc *FortiSDKClient) error) (transaction bool, err error) {
+	tc, errt := c.StartTransaction(renameTransactionTimeout)
+	if errt != nil {
+		log.Printf("FOS-fortios cannot start a transaction, renaming without it: %s", errt)
+		err = fn(c)
+		return
+	}
+
+	transaction = true
+
+	err = fn(tc)
+	if err != nil {
+		tc.AbortTransaction()
+		return
+	}
+
+	err = tc.CommitTransaction()
+
+	return
+}
+
+// renameRecreated checks the committed rename. Some firmwares create a new object
+// instead of renaming the existing one, the old object then still exists next to the new one.
+// Returns true when the object was recreated, and error when no object has the new name.
+func (c *FortiSDKClient) renameRecreated(table string, oldName string, newName string) (recreated bool, err error) {
+	o, err := c.readCmdbObject(table, oldName)
+	if err != nil {
+		return
+	}
+
+	n, err := c.readCmdbObject(table, newName)
+	if err != nil {
+		return
+	}
+	if n == nil {
+		err = fmt.Errorf("%s %s was not renamed to %s", table, oldName, newName)
+		return
+	}
+
+	recreated = o != nil
+
+	return
+}
+
+// moveReferences moves the references of a recreated object to the new name
+// and deletes the old object
+func (c *FortiSDKClient) moveReferences(table string, oldName string, newName string, refs []JSONObjectReference) (err error) {
+	for _, r := range refs {
+		err = c.replaceMember(r, oldName, newName)
+		if err != nil {
+			return
+		}
+	}
+
+	err = c.deleteCmdbObject(table, oldName)
+
+	return
+}
+
+// undoRecreatedRename restores the references moved to the new name of a recreated object
+// and deletes the new object, the old object is left as it was before the rename
+func (c *FortiSDKClient) undoRecreatedRename(table string, oldName string, newName string, refs []JSONObjectReference) (err error) {
+	for _, r := range refs {
+		err = c.replaceMember(r, newName, oldName)
+		if err != nil {
+			return
+		}
+	}
+
+	err = c.deleteCmdbObject(table, newName)
+
+	return
+}
+
+// replaceMember replaces oldName by newName in the member attribute of the reference
+func (c *FortiSDKClient) replaceMember(r JSONObjectReference, oldName string, newName string) (err error) {
+	o, err := c.readCmdbObject(r.Table, r.Mkey)
+	if err != nil || o == nil {
+		return
+	}
+
+	if s, ok := o[r.Attribute].(string); ok {
+		if s != oldName {
+			return
+		}
+
+		err = c.updateCmdbObject(r.Table, r.Mkey, map[string]interface{}{
+			r.Attribute: newName,
+		})
+		if err != nil {
+			err = fmt.Errorf("cannot update %s %s: %s", r.Table, r.Mkey, err)
+		}
+		return
+	}
+
+	names := memberNames(o[r.Attribute])
+	changed := false
+	for i, n := range names {
+		if n == oldName {
+			names[i] = newName
+			changed = true
+		}
+	}
+
+	if !changed {
+		return
+	}
+
+	err = c.updateCmdbObject(r.Table, r.Mkey, map[string]interface{}{
+		r.Attribute: memberList(names),
+	})
+	if err != nil {
+		err = fmt.Errorf("cannot update %s %s: %s", r.Table, r.Mkey, err)
+	}
+
+	return
+}
+
+// verifyRename checks that all the references point at the new name
+// and returns the verified references
+func (c *FortiSDKClient) verifyRename(oldName string, newName string, refs []JSONObjectReference) (output []JSONObjectReference, err error) {
+	output = []JSONObjectReference{}
+
+	for _, r := range refs {
+		o, errr := c.readCmdbObject(r.Table, r.Mkey)
+		if errr != nil {
+			err = errr
+			return
+		}
+		if o == nil {
+			err = fmt.Errorf("%s %s disappeared during the rename", r.Table, r.Mkey)
+			return
+		}
+
+		names := memberNames(o[r.Attribute])
+		if s, ok := o[r.Attribute].(string); ok {
+			names = []string{s}
+		}
+
+		found := false
+		for _, n := range names {
+			if n == oldName {
+				err = fmt.Errorf("%s %s still references %s in %s", r.Table, r.Mkey, oldName, r.Attribute)
+				return
+			}
+			if n == newName {
+				found = true
+			}
+		}
+
+		if !found {
+			err = fmt.Errorf("%s %s does not reference %s in %s", r.Table, r.Mkey, newName, r.Attribute)
+			return
+		}
+
+		output = append(output, r)
+	}
+
+	return
+}
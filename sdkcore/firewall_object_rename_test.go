@@ -0,0 +1,186 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+)
+
+// fakeRecreatingCmdb emulates a firmware without cmdb transactions, which creates
+// a new firewall address on a rename instead of renaming the existing one
+type fakeRecreatingCmdb struct {
+	mu      sync.Mutex
+	tables  map[string]map[string]map[string]interface{}
+	failPut map[string]bool
+}
+
+func (d *fakeRecreatingCmdb) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	reply := map[string]interface{}{"status": "error", "http_status": 404, "error": -3}
+	defer func() { json.NewEncoder(w).Encode(reply) }()
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v2/cmdb/"), "/")
+	if !strings.HasPrefix(r.URL.Path, "/api/v2/cmdb/") || len(parts) < 2 || len(parts) > 3 {
+		return
+	}
+	table := parts[0] + "/" + parts[1]
+	objs, ok := d.tables[table]
+	if !ok {
+		return
+	}
+	mkey := ""
+	if len(parts) == 3 {
+		mkey = parts[2]
+	}
+	success := map[string]interface{}{"status": "success", "http_status": 200}
+
+	switch r.Method {
+	case "GET":
+		keys := []string{}
+		for k := range objs {
+			if mkey == "" || k == mkey {
+				keys = append(keys, k)
+			}
+		}
+		if mkey != "" && len(keys) == 0 {
+			return
+		}
+		sort.Strings(keys)
+
+		results := []interface{}{}
+		for _, k := range keys {
+			results = append(results, objs[k])
+		}
+		reply = success
+		reply["results"] = results
+
+	case "PUT":
+		o, ok := objs[mkey]
+		if !ok {
+			return
+		}
+		if d.failPut[table+"/"+mkey] {
+			reply = map[string]interface{}{"status": "error", "http_status": 500, "error": -1}
+			return
+		}
+
+		var attrs map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&attrs)
+		if name, ok := attrs["name"].(string); ok && table == "firewall/address" && name != mkey {
+			n := map[string]interface{}{}
+			for k, v := range o {
+				n[k] = v
+			}
+			n["name"] = name
+			objs[name] = n
+		} else {
+			for k, v := range attrs {
+				o[k] = v
+			}
+		}
+		reply = success
+
+	case "DELETE":
+		if _, ok := objs[mkey]; !ok {
+			return
+		}
+		delete(objs, mkey)
+		reply = success
+	}
+}
+
+func members(names ...string) []interface{} {
+	out := []interface{}{}
+	for _, n := range names {
+		out = append(out, map[string]interface{}{"name": n})
+	}
+	return out
+}
+
+// newRenameTestDevice returns a fake device where the address old is used by
+// the address group grp and the firewall policy 1
+func newRenameTestDevice(t *testing.T, failPut map[string]bool) (*FortiSDKClient, *fakeRecreatingCmdb) {
+	d := &fakeRecreatingCmdb{
+		tables: map[string]map[string]map[string]interface{}{
+			"firewall/address": {
+				"old": {"name": "old", "subnet": "10.0.0.0 255.255.255.0"},
+			},
+			"firewall/addrgrp": {
+				"grp": {"name": "grp", "member": members("old", "other")},
+			},
+			"firewall/policy": {
+				"1": {"policyid": 1.0, "srcaddr": members("old"), "dstaddr": members("all")},
+			},
+		},
+		failPut: failPut,
+	}
+
+	device := httptest.NewTLSServer(d)
+	t.Cleanup(device.Close)
+
+	u, err := url.Parse(device.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client()), d
+}
+
+func addressNames(d *fakeRecreatingCmdb) []string {
+	names := []string{}
+	for n := range d.tables["firewall/address"] {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestRenameRecreated(t *testing.T) {
+	c, d := newRenameTestDevice(t, nil)
+
+	output, err := c.Rename("firewall/address", "old", "new")
+	if err != nil {
+		t.Fatalf("Rename() error = %v", err)
+	}
+	if !output.Recreated || output.Transaction || len(output.References) != 2 {
+		t.Errorf("Rename() = %+v, want recreated without transaction and 2 references", output)
+	}
+
+	if got := addressNames(d); !reflect.DeepEqual(got, []string{"new"}) {
+		t.Errorf("addresses = %v, want [new]", got)
+	}
+	if got := memberNames(d.tables["firewall/addrgrp"]["grp"]["member"]); !reflect.DeepEqual(got, []string{"new", "other"}) {
+		t.Errorf("grp members = %v, want [new other]", got)
+	}
+	if got := memberNames(d.tables["firewall/policy"]["1"]["srcaddr"]); !reflect.DeepEqual(got, []string{"new"}) {
+		t.Errorf("policy 1 srcaddr = %v, want [new]", got)
+	}
+}
+
+func TestRenameRecreatedUndo(t *testing.T) {
+	// grp is moved to the new name, then the update of policy 1 fails
+	c, d := newRenameTestDevice(t, map[string]bool{"firewall/policy/1": true})
+
+	if _, err := c.Rename("firewall/address", "old", "new"); err == nil {
+		t.Fatal("Rename() error = nil, want the policy update error")
+	}
+
+	if got := addressNames(d); !reflect.DeepEqual(got, []string{"old"}) {
+		t.Errorf("addresses = %v, want [old]", got)
+	}
+	if got := memberNames(d.tables["firewall/addrgrp"]["grp"]["member"]); !reflect.DeepEqual(got, []string{"old", "other"}) {
+		t.Errorf("grp members = %v, want [old other]", got)
+	}
+	if got := memberNames(d.tables["firewall/policy"]["1"]["srcaddr"]); !reflect.DeepEqual(got, []string{"old"}) {
+		t.Errorf("policy 1 srcaddr = %v, want [old]", got)
+	}
+}
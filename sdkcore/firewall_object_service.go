@@ -68,6 +68,12 @@ func (c *FortiSDKClient) CreateFirewallObjectService(params *JSONFirewallObjectS
 	HTTPMethod := "POST"
 	path := "/api/v2/cmdb/firewall.service/custom"
 	output = &JSONCreateFirewallObjectServiceOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
@@ -141,6 +147,12 @@ func (c *FortiSDKClient) UpdateFirewallObjectService(params *JSONFirewallObjectS
 	path := "/api/v2/cmdb/firewall.service/custom"
 	path += "/" + EscapeURLString(mkey)
 	output = &JSONUpdateFirewallObjectServiceOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
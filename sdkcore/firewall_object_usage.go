@@ -0,0 +1,429 @@
+package forticlient
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// JSONObjectReference describes an object referencing another object
+type JSONObjectReference struct {
+	// Table is the cmdb table of the referencing object, such as "firewall/policy"
+	Table string `json:"table"`
+	// Mkey is the index value of the referencing object
+	Mkey string `json:"mkey"`
+	// Attribute is the attribute holding the reference, such as "srcaddr"
+	Attribute string `json:"attribute"`
+}
+
+// ReferencedError is returned by SafeDelete when the object is still referenced
+type ReferencedError struct {
+	Table      string
+	Mkey       string
+	References []JSONObjectReference
+}
+
+func (e *ReferencedError) Error() string {
+	refs := make([]string, 0, len(e.References))
+	for _, r := range e.References {
+		refs = append(refs, fmt.Sprintf("%s %s (%s)", r.Table, r.Mkey, r.Attribute))
+	}
+	return fmt.Sprintf("%s %s is still used by %s", e.Table, e.Mkey, strings.Join(refs, ", "))
+}
+
+// referenceScan lists the member attributes which can reference an object of a table,
+// it is used when FortiOS does not provide the object usage monitor
+var referenceScan = map[string][]JSONObjectReference{
+	"firewall/address": {
+		{Table: "firewall/addrgrp", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "srcaddr"},
+		{Table: "firewall/policy", Attribute: "dstaddr"},
+		{Table: "firewall/local-in-policy", Attribute: "srcaddr"},
+		{Table: "firewall/local-in-policy", Attribute: "dstaddr"},
+		{Table: "firewall/DoS-policy", Attribute: "srcaddr"},
+		{Table: "firewall/DoS-policy", Attribute: "dstaddr"},
+		{Table: "firewall/central-snat-map", Attribute: "orig-addr"},
+		{Table: "firewall/central-snat-map", Attribute: "dst-addr"},
+		{Table: "firewall/shaping-policy", Attribute: "srcaddr"},
+		{Table: "firewall/shaping-policy", Attribute: "dstaddr"},
+		{Table: "router/policy", Attribute: "srcaddr"},
+		{Table: "router/policy", Attribute: "dstaddr"},
+	},
+	"firewall/addrgrp": {
+		{Table: "firewall/addrgrp", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "srcaddr"},
+		{Table: "firewall/policy", Attribute: "dstaddr"},
+		{Table: "firewall/local-in-policy", Attribute: "srcaddr"},
+		{Table: "firewall/local-in-policy", Attribute: "dstaddr"},
+		{Table: "firewall/DoS-policy", Attribute: "srcaddr"},
+		{Table: "firewall/DoS-policy", Attribute: "dstaddr"},
+		{Table: "firewall/central-snat-map", Attribute: "orig-addr"},
+		{Table: "firewall/central-snat-map", Attribute: "dst-addr"},
+		{Table: "firewall/shaping-policy", Attribute: "srcaddr"},
+		{Table: "firewall/shaping-policy", Attribute: "dstaddr"},
+		{Table: "router/policy", Attribute: "srcaddr"},
+		{Table: "router/policy", Attribute: "dstaddr"},
+	},
+	"firewall/address6": {
+		{Table: "firewall/addrgrp6", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "srcaddr6"},
+		{Table: "firewall/policy", Attribute: "dstaddr6"},
+		{Table: "firewall/local-in-policy6", Attribute: "srcaddr"},
+		{Table: "firewall/local-in-policy6", Attribute: "dstaddr"},
+	},
+	"firewall/addrgrp6": {
+		{Table: "firewall/addrgrp6", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "srcaddr6"},
+		{Table: "firewall/policy", Attribute: "dstaddr6"},
+		{Table: "firewall/local-in-policy6", Attribute: "srcaddr"},
+		{Table: "firewall/local-in-policy6", Attribute: "dstaddr"},
+	},
+	"firewall/vip": {
+		{Table: "firewall/vipgrp", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "dstaddr"},
+	},
+	"firewall/vipgrp": {
+		{Table: "firewall/policy", Attribute: "dstaddr"},
+	},
+	"firewall/ldb-monitor": {
+		{Table: "firewall/vip", Attribute: "monitor"},
+	},
+	"firewall/vip6": {
+		{Table: "firewall/policy", Attribute: "dstaddr6"},
+	},
+	"firewall/vip46": {
+		{Table: "firewall/vipgrp46", Attribute: "member"},
+		{Table: "firewall/policy46", Attribute: "dstaddr"},
+	},
+	"firewall/vip64": {
+		{Table: "firewall/vipgrp64", Attribute: "member"},
+		{Table: "firewall/policy64", Attribute: "dstaddr"},
+	},
+	"firewall/ippool": {
+		{Table: "firewall/policy", Attribute: "poolname"},
+		{Table: "firewall/central-snat-map", Attribute: "nat-ippool"},
+	},
+	"firewall/ippool6": {
+		{Table: "firewall/policy", Attribute: "poolname6"},
+		{Table: "firewall/policy64", Attribute: "poolname"},
+	},
+	"firewall.schedule/onetime": {
+		{Table: "firewall.schedule/group", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "schedule"},
+		{Table: "firewall/local-in-policy", Attribute: "schedule"},
+		{Table: "firewall/local-in-policy6", Attribute: "schedule"},
+	},
+	"firewall.schedule/recurring": {
+		{Table: "firewall.schedule/group", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "schedule"},
+		{Table: "firewall/local-in-policy", Attribute: "schedule"},
+		{Table: "firewall/local-in-policy6", Attribute: "schedule"},
+	},
+	"firewall.schedule/group": {
+		{Table: "firewall/policy", Attribute: "schedule"},
+		{Table: "firewall/local-in-policy", Attribute: "schedule"},
+		{Table: "firewall/local-in-policy6", Attribute: "schedule"},
+	},
+	"firewall.service/custom": {
+		{Table: "firewall.service/group", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "service"},
+		{Table: "firewall/local-in-policy", Attribute: "service"},
+		{Table: "firewall/local-in-policy6", Attribute: "service"},
+		{Table: "firewall/DoS-policy", Attribute: "service"},
+		{Table: "firewall/shaping-policy", Attribute: "service"},
+	},
+	"firewall.service/group": {
+		{Table: "firewall.service/group", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "service"},
+		{Table: "firewall/local-in-policy", Attribute: "service"},
+		{Table: "firewall/local-in-policy6", Attribute: "service"},
+		{Table: "firewall/DoS-policy", Attribute: "service"},
+		{Table: "firewall/shaping-policy", Attribute: "service"},
+	},
+	"antivirus/profile": {
+		{Table: "firewall/policy", Attribute: "av-profile"},
+	},
+	"webfilter/profile": {
+		{Table: "firewall/policy", Attribute: "webfilter-profile"},
+	},
+	"dnsfilter/profile": {
+		{Table: "firewall/policy", Attribute: "dnsfilter-profile"},
+	},
+	"ips/sensor": {
+		{Table: "firewall/policy", Attribute: "ips-sensor"},
+	},
+	"application/list": {
+		{Table: "firewall/policy", Attribute: "application-list"},
+	},
+	"firewall/ssl-ssh-profile": {
+		{Table: "firewall/policy", Attribute: "ssl-ssh-profile"},
+	},
+	"firewall/profile-protocol-options": {
+		{Table: "firewall/policy", Attribute: "profile-protocol-options"},
+	},
+	"firewall.shaper/traffic-shaper": {
+		{Table: "firewall/shaping-policy", Attribute: "traffic-shaper"},
+		{Table: "firewall/shaping-policy", Attribute: "traffic-shaper-reverse"},
+	},
+	"firewall.shaper/per-ip-shaper": {
+		{Table: "firewall/shaping-policy", Attribute: "per-ip-shaper"},
+	},
+	"firewall/internet-service-custom": {
+		{Table: "firewall/internet-service-group", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "internet-service-custom"},
+		{Table: "firewall/policy", Attribute: "internet-service-src-custom"},
+	},
+	"firewall/internet-service-group": {
+		{Table: "firewall/policy", Attribute: "internet-service-group"},
+		{Table: "firewall/policy", Attribute: "internet-service-src-group"},
+	},
+	"user/local": {
+		{Table: "user/group", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "users"},
+	},
+	"user/group": {
+		{Table: "firewall/policy", Attribute: "groups"},
+	},
+	"user/ldap": {
+		{Table: "user/local", Attribute: "ldap-server"},
+		{Table: "user/group", Attribute: "member"},
+	},
+	"user/radius": {
+		{Table: "user/local", Attribute: "radius-server"},
+		{Table: "user/group", Attribute: "member"},
+	},
+	"user/tacacs+": {
+		{Table: "user/local", Attribute: "tacacs+-server"},
+		{Table: "user/group", Attribute: "member"},
+	},
+	"user/saml": {
+		{Table: "user/group", Attribute: "member"},
+	},
+	"system/external-resource": {
+		{Table: "firewall/addrgrp", Attribute: "member"},
+		{Table: "firewall/policy", Attribute: "srcaddr"},
+		{Table: "firewall/policy", Attribute: "dstaddr"},
+	},
+	"router/route-map": {
+		{Table: "router/bgp/neighbor", Attribute: "route-map-in"},
+		{Table: "router/bgp/neighbor", Attribute: "route-map-out"},
+		{Table: "router/bgp/neighbor-group", Attribute: "route-map-in"},
+		{Table: "router/bgp/neighbor-group", Attribute: "route-map-out"},
+		{Table: "router/bgp/network", Attribute: "route-map"},
+	},
+	"router/prefix-list": {
+		{Table: "router/bgp/neighbor", Attribute: "prefix-list-in"},
+		{Table: "router/bgp/neighbor", Attribute: "prefix-list-out"},
+		{Table: "router/bgp/neighbor-group", Attribute: "prefix-list-in"},
+		{Table: "router/bgp/neighbor-group", Attribute: "prefix-list-out"},
+	},
+}
+
+// tableKeys maps the tables not keyed by name to their key attribute
+var tableKeys = map[string]string{
+	"firewall/DoS-policy":       "policyid",
+	"firewall/central-snat-map": "policyid",
+	"firewall/local-in-policy":  "policyid",
+	"firewall/local-in-policy6": "policyid",
+	"firewall/policy":           "policyid",
+	"firewall/policy46":         "policyid",
+	"firewall/policy64":         "policyid",
+	"firewall/shaping-policy":   "id",
+	"router/bgp/neighbor":       "ip",
+	"router/bgp/network":        "id",
+	"router/policy":             "seq-num",
+	"router/static":             "seq-num",
+	"router/static6":            "seq-num",
+}
+
+// WhereUsed API operation for FortiOS returns every object referencing the object
+// of the specified table and index value, such as the address groups and the policies
+// using a firewall address.
+// The table is the cmdb path of the object, such as "firewall/address".
+// The object usage monitor is used, a scan of the referencing tables is done
+// when the device does not support it.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) WhereUsed(table string, mkey string) (output []JSONObjectReference, err error) {
+	output, err = c.whereUsedMonitor(table, mkey)
+	if err == nil {
+		return
+	}
+
+	log.Printf("FOS-fortios object usage monitor failed, scanning tables: %s", err)
+
+	return c.whereUsedScan(table, mkey)
+}
+
+// whereUsedMonitor gets the references from the object usage monitor
+func (c *FortiSDKClient) whereUsedMonitor(table string, mkey string) (output []JSONObjectReference, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/monitor/system/object/usage"
+
+	qPath, qName, err := splitTable(table)
+	if err != nil {
+		return
+	}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	req.FillUrlParam("q_path", qPath)
+	req.FillUrlParam("q_name", qName)
+	req.FillUrlParam("mkey", mkey)
+
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	mapTmp, _ := result["results"].(map[string]interface{})
+	if mapTmp == nil {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	output = []JSONObjectReference{}
+
+	using, _ := mapTmp["currently_using"].([]interface{})
+	for _, v := range using {
+		u, _ := v.(map[string]interface{})
+		if u == nil {
+			continue
+		}
+
+		ref := JSONObjectReference{}
+		if u["path"] != nil && u["name"] != nil {
+			ref.Table = u["path"].(string) + "/" + u["name"].(string)
+		}
+		ref.Mkey = mkeyString(u["mkey"])
+		if u["attribute"] != nil {
+			ref.Attribute = u["attribute"].(string)
+		}
+
+		output = append(output, ref)
+	}
+
+	return
+}
+
+// whereUsedScan gets the references by reading all the tables which can reference the object.
+// The tables the firmware does not have are skipped.
+func (c *FortiSDKClient) whereUsedScan(table string, mkey string) (output []JSONObjectReference, err error) {
+	scans, ok := referenceScan[table]
+	if !ok {
+		err = fmt.Errorf("cannot scan the references of %s", table)
+		return
+	}
+
+	output = []JSONObjectReference{}
+
+	objects := make(map[string][]map[string]interface{})
+	for _, s := range scans {
+		list, ok := objects[s.Table]
+		if !ok {
+			list, _, err = c.listCmdbTableIfExists(s.Table)
+			if err != nil {
+				return
+			}
+			objects[s.Table] = list
+		}
+
+		key := tableKeys[s.Table]
+		if key == "" {
+			key = "name"
+		}
+
+		for _, o := range list {
+			for _, n := range memberNames(o[s.Attribute]) {
+				if n == mkey {
+					output = append(output, JSONObjectReference{
+						Table:     s.Table,
+						Mkey:      mkeyString(o[key]),
+						Attribute: s.Attribute,
+					})
+					break
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// SafeDelete API operation for FortiOS deletes the object of the specified table and index value
+// only when no other object references it.
+// With detach, the object is first removed from the member attributes referencing it,
+// such as the address group members or the policy source addresses. Every reference is
+// checked before the first change, nothing is changed when one of them cannot be removed.
+// Returns a *ReferencedError when the object is still referenced and detach is false,
+// or when a reference cannot be removed because the object is the only member of the attribute
+// or because the attribute is not known.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) SafeDelete(table string, mkey string, detach bool) (err error) {
+	refs, err := c.WhereUsed(table, mkey)
+	if err != nil {
+		return
+	}
+
+	if len(refs) > 0 {
+		if !detach {
+			err = &ReferencedError{Table: table, Mkey: mkey, References: refs}
+			return
+		}
+
+		type detachment struct {
+			ref  JSONObjectReference
+			kept []string
+		}
+
+		var left []JSONObjectReference
+		var changes []detachment
+		for _, r := range refs {
+			if r.Attribute == "" {
+				left = append(left, r)
+				continue
+			}
+
+			o, errr := c.readCmdbObject(r.Table, r.Mkey)
+			if errr != nil {
+				err = errr
+				return
+			}
+			if o == nil {
+				continue
+			}
+
+			names := memberNames(o[r.Attribute])
+			kept := make([]string, 0, len(names))
+			for _, n := range names {
+				if n != mkey {
+					kept = append(kept, n)
+				}
+			}
+
+			if len(kept) == len(names) || len(kept) == 0 {
+				left = append(left, r)
+				continue
+			}
+
+			changes = append(changes, detachment{ref: r, kept: kept})
+		}
+
+		if len(left) > 0 {
+			err = &ReferencedError{Table: table, Mkey: mkey, References: left}
+			return
+		}
+
+		for _, d := range changes {
+			err = c.updateCmdbObject(d.ref.Table, d.ref.Mkey, map[string]interface{}{
+				d.ref.Attribute: memberList(d.kept),
+			})
+			if err != nil {
+				err = fmt.Errorf("cannot remove %s from %s %s: %s", mkey, d.ref.Table, d.ref.Mkey, err)
+				return
+			}
+		}
+	}
+
+	err = c.deleteCmdbObject(table, mkey)
+
+	return
+}
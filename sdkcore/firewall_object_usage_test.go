@@ -0,0 +1,61 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+)
+
+// newUsageTestClient returns a client of a fake device serving the tables,
+// without the object usage monitor. The other tables do not exist, except
+// the ones in broken which fail with an internal error.
+func newUsageTestClient(t *testing.T, tables map[string][]interface{}, broken map[string]bool) *FortiSDKClient {
+	device := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reply := map[string]interface{}{"status": "error", "http_status": 404, "error": -3}
+		if list, ok := tables[r.URL.Path]; ok {
+			reply = map[string]interface{}{"status": "success", "http_status": 200, "results": list}
+		} else if broken[r.URL.Path] {
+			reply = map[string]interface{}{"status": "error", "http_status": 500, "error": -1}
+		}
+		json.NewEncoder(w).Encode(reply)
+	}))
+	t.Cleanup(device.Close)
+
+	u, err := url.Parse(device.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client())
+}
+
+func TestWhereUsedScanSkipsMissingTables(t *testing.T) {
+	// firewall/vipgrp64 does not exist on this firmware
+	c := newUsageTestClient(t, map[string][]interface{}{
+		"/api/v2/cmdb/firewall/policy64": {
+			map[string]interface{}{"policyid": 3.0, "dstaddr": []interface{}{map[string]interface{}{"name": "vip-a"}}},
+			map[string]interface{}{"policyid": 4.0, "dstaddr": []interface{}{map[string]interface{}{"name": "vip-b"}}},
+		},
+	}, nil)
+
+	got, err := c.WhereUsed("firewall/vip64", "vip-a")
+	if err != nil {
+		t.Fatalf("WhereUsed() error = %v", err)
+	}
+	want := []JSONObjectReference{{Table: "firewall/policy64", Mkey: "3", Attribute: "dstaddr"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WhereUsed() = %v, want %v", got, want)
+	}
+}
+
+func TestWhereUsedScanFailsOnErrors(t *testing.T) {
+	c := newUsageTestClient(t, nil, map[string]bool{"/api/v2/cmdb/firewall/vipgrp64": true})
+
+	if _, err := c.WhereUsed("firewall/vip64", "vip-a"); err == nil {
+		t.Error("WhereUsed() error = nil, want the table error")
+	}
+}
@@ -11,16 +11,33 @@ import (
 )
 
 // JSONFirewallObjectVip contains the parameters for Create and Update API function
+// The server load balancing parameters are only sent when they are set.
 type JSONFirewallObjectVip struct {
-	Name        string        `json:"name"`
-	Comment     string        `json:"comment"`
-	Extip       string        `json:"extip"`
-	Mappedip    VIPMultValues `json:"mappedip"`
-	Extintf     string        `json:"extintf"`
-	Portforward string        `json:"portforward"`
-	Protocol    string        `json:"protocol"`
-	Extport     string        `json:"extport"`
-	Mappedport  string        `json:"mappedport"`
+	Name                      string                  `json:"name"`
+	Comment                   string                  `json:"comment"`
+	Extip                     string                  `json:"extip"`
+	Mappedip                  VIPMultValues           `json:"mappedip"`
+	Extintf                   string                  `json:"extintf"`
+	Portforward               string                  `json:"portforward"`
+	Protocol                  string                  `json:"protocol"`
+	Extport                   string                  `json:"extport"`
+	Mappedport                string                  `json:"mappedport"`
+	Type                      string                  `json:"type,omitempty"`
+	ServerType                string                  `json:"server-type,omitempty"`
+	LdbMethod                 string                  `json:"ldb-method,omitempty"`
+	Realservers               VIPRealserverMultValues `json:"realservers,omitempty"`
+	Monitor                   MultValues              `json:"monitor,omitempty"`
+	Persistence               string                  `json:"persistence,omitempty"`
+	HTTPCookieDomain          string                  `json:"http-cookie-domain,omitempty"`
+	HTTPCookieAge             int                     `json:"http-cookie-age,omitempty"`
+	HTTPIPHeader              string                  `json:"http-ip-header,omitempty"`
+	HTTPMultiplex             string                  `json:"http-multiplex,omitempty"`
+	SslMode                   string                  `json:"ssl-mode,omitempty"`
+	SslCertificate            string                  `json:"ssl-certificate,omitempty"`
+	SslMinVersion             string                  `json:"ssl-min-version,omitempty"`
+	SslMaxVersion             string                  `json:"ssl-max-version,omitempty"`
+	SslHTTPLocationConversion string                  `json:"ssl-http-location-conversion,omitempty"`
+	SslSendEmptyFrags         string                  `json:"ssl-send-empty-frags,omitempty"`
 }
 
 // JSONCreateFirewallObjectVipOutput contains the output results for Create API function
@@ -48,6 +65,22 @@ type VIPMultValue struct {
 // VIPMultValues contains the output results for Read API function
 type VIPMultValues []VIPMultValue
 
+// VIPRealserverMultValue contains the real server parameters of a server load balancing virtual IP
+type VIPRealserverMultValue struct {
+	ID               int    `json:"id,omitempty"`
+	IP               string `json:"ip"`
+	Port             int    `json:"port"`
+	Weight           int    `json:"weight,omitempty"`
+	Status           string `json:"status"`
+	Healthcheck      string `json:"healthcheck"`
+	HoldDownInterval int    `json:"holddown-interval,omitempty"`
+	MaxConnections   int    `json:"max-connections"`
+	HTTPHost         string `json:"http-host"`
+}
+
+// VIPRealserverMultValues contains the output results for Read API function
+type VIPRealserverMultValues []VIPRealserverMultValue
+
 // CreateFirewallObjectVip API operation for FortiOS creates a new firewall virtual IP.
 // Returns the index value of the firewall virtual IP and execution result when the request executes successfully.
 // Returns error for service API and SDK errors.
@@ -363,6 +396,100 @@ func (c *FortiSDKClient) ReadFirewallObjectVip(mkey string) (output *JSONFirewal
 		if mapTmp["mappedport"] != nil {
 			output.Mappedport = mapTmp["mappedport"].(string)
 		}
+		if mapTmp["type"] != nil {
+			output.Type = mapTmp["type"].(string)
+		}
+		if mapTmp["server-type"] != nil {
+			output.ServerType = mapTmp["server-type"].(string)
+		}
+		if mapTmp["ldb-method"] != nil {
+			output.LdbMethod = mapTmp["ldb-method"].(string)
+		}
+		if mapTmp["realservers"] != nil {
+			member := mapTmp["realservers"].([]interface{})
+
+			var members []VIPRealserverMultValue
+			for _, v := range member {
+				c := v.(map[string]interface{})
+				m := VIPRealserverMultValue{}
+				if c["id"] != nil {
+					m.ID = int(c["id"].(float64))
+				}
+				if c["ip"] != nil {
+					m.IP = c["ip"].(string)
+				}
+				if c["port"] != nil {
+					m.Port = int(c["port"].(float64))
+				}
+				if c["weight"] != nil {
+					m.Weight = int(c["weight"].(float64))
+				}
+				if c["status"] != nil {
+					m.Status = c["status"].(string)
+				}
+				if c["healthcheck"] != nil {
+					m.Healthcheck = c["healthcheck"].(string)
+				}
+				if c["holddown-interval"] != nil {
+					m.HoldDownInterval = int(c["holddown-interval"].(float64))
+				}
+				if c["max-connections"] != nil {
+					m.MaxConnections = int(c["max-connections"].(float64))
+				}
+				if c["http-host"] != nil {
+					m.HTTPHost = c["http-host"].(string)
+				}
+				members = append(members, m)
+			}
+			output.Realservers = members
+		}
+		if mapTmp["monitor"] != nil {
+			member := mapTmp["monitor"].([]interface{})
+
+			var members []MultValue
+			for _, v := range member {
+				c := v.(map[string]interface{})
+
+				members = append(members,
+					MultValue{
+						Name: c["name"].(string),
+					})
+			}
+			output.Monitor = members
+		}
+		if mapTmp["persistence"] != nil {
+			output.Persistence = mapTmp["persistence"].(string)
+		}
+		if mapTmp["http-cookie-domain"] != nil {
+			output.HTTPCookieDomain = mapTmp["http-cookie-domain"].(string)
+		}
+		if mapTmp["http-cookie-age"] != nil {
+			output.HTTPCookieAge = int(mapTmp["http-cookie-age"].(float64))
+		}
+		if mapTmp["http-ip-header"] != nil {
+			output.HTTPIPHeader = mapTmp["http-ip-header"].(string)
+		}
+		if mapTmp["http-multiplex"] != nil {
+			output.HTTPMultiplex = mapTmp["http-multiplex"].(string)
+		}
+		if mapTmp["ssl-mode"] != nil {
+			output.SslMode = mapTmp["ssl-mode"].(string)
+		}
+		if mapTmp["ssl-certificate"] != nil {
+			output.SslCertificate = mapTmp["ssl-certificate"].(string)
+		}
+		if mapTmp["ssl-min-version"] != nil {
+			output.SslMinVersion = mapTmp["ssl-min-version"].(string)
+		}
+		if mapTmp["ssl-max-version"] != nil {
+			output.SslMaxVersion = mapTmp["ssl-max-version"].(string)
+		}
+		if mapTmp["ssl-http-location-conversion"] != nil {
+			output.SslHTTPLocationConversion = mapTmp["ssl-http-location-conversion"].(string)
+		}
+		if mapTmp["ssl-send-empty-frags"] != nil {
+			output.SslSendEmptyFrags = mapTmp["ssl-send-empty-frags"].(string)
+		}
 
 	} else {
 		err = fmt.Errorf("cannot get the right response")
@@ -0,0 +1,375 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallObjectVip64 contains the parameters for Create and Update API function
+type JSONFirewallObjectVip64 struct {
+	Name        string `json:"name"`
+	Comment     string `json:"comment"`
+	Extip       string `json:"extip"`
+	Mappedip    string `json:"mappedip"`
+	ArpReply    string `json:"arp-reply"`
+	Portforward string `json:"portforward"`
+	Protocol    string `json:"protocol"`
+	Extport     string `json:"extport"`
+	Mappedport  string `json:"mappedport"`
+}
+
+// JSONCreateFirewallObjectVip64Output contains the output results for Create API function
+type JSONCreateFirewallObjectVip64Output struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallObjectVip64Output contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateFirewallObjectVip64Output struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallObjectVip64 API operation for FortiOS creates a new firewall IPv6 to IPv4 virtual IP.
+// Returns the index value of the firewall IPv6 to IPv4 virtual IP and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - vip64 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallObjectVip64(params *JSONFirewallObjectVip64) (output *JSONCreateFirewallObjectVip64Output, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/vip64"
+	output = &JSONCreateFirewallObjectVip64Output{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallObjectVip64 API operation for FortiOS updates the specified firewall IPv6 to IPv4 virtual IP.
+// Returns the index value of the firewall IPv6 to IPv4 virtual IP and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - vip64 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallObjectVip64(params *JSONFirewallObjectVip64, mkey string) (output *JSONUpdateFirewallObjectVip64Output, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/vip64"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateFirewallObjectVip64Output{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallObjectVip64 API operation for FortiOS deletes the specified firewall IPv6 to IPv4 virtual IP.
+// Returns error for service API and SDK errors.
+// See the firewall - vip64 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallObjectVip64(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/vip64"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallObjectVip64 API operation for FortiOS gets the firewall IPv6 to IPv4 virtual IP
+// with the specified index value.
+// Returns the requested firewall IPv6 to IPv4 virtual IP value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - vip64 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallObjectVip64(mkey string) (output *JSONFirewallObjectVip64, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/vip64"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallObjectVip64{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallObjectVip64(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallObjectVip64s API operation for FortiOS gets all the firewall IPv6 to IPv4 virtual IPs.
+// Returns the firewall IPv6 to IPv4 virtual IPs when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - vip64 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallObjectVip64s() (output []*JSONFirewallObjectVip64, err error) {
+	results, err := c.listCmdbTable("firewall/vip64")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallObjectVip64, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallObjectVip64{}
+		fillFirewallObjectVip64(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallObjectVip64 fills output from a firewall IPv6 to IPv4 virtual IP of the response
+func fillFirewallObjectVip64(output *JSONFirewallObjectVip64, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["extip"] != nil {
+		output.Extip = mapTmp["extip"].(string)
+	}
+	if mapTmp["mappedip"] != nil {
+		output.Mappedip = mapTmp["mappedip"].(string)
+	}
+	if mapTmp["arp-reply"] != nil {
+		output.ArpReply = mapTmp["arp-reply"].(string)
+	}
+	if mapTmp["portforward"] != nil {
+		output.Portforward = mapTmp["portforward"].(string)
+	}
+	if mapTmp["protocol"] != nil {
+		output.Protocol = mapTmp["protocol"].(string)
+	}
+	if mapTmp["extport"] != nil {
+		output.Extport = mapTmp["extport"].(string)
+	}
+	if mapTmp["mappedport"] != nil {
+		output.Mappedport = mapTmp["mappedport"].(string)
+	}
+}
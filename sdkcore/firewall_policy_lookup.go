@@ -0,0 +1,76 @@
+package forticlient
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// JSONFirewallPolicyLookup contains the parameters for Lookup API function
+// Protocol is "tcp", "udp", "icmp" or an IP protocol number,
+// Destport is used for TCP, UDP and SCTP and Icmptype/Icmpcode for ICMP
+type JSONFirewallPolicyLookup struct {
+	Srcintf    string `json:"srcintf"`
+	Sourceip   string `json:"sourceip"`
+	Dest       string `json:"dest"`
+	Protocol   string `json:"protocol"`
+	Sourceport int    `json:"sourceport"`
+	Destport   int    `json:"destport"`
+	Icmptype   int    `json:"icmptype"`
+	Icmpcode   int    `json:"icmpcode"`
+}
+
+// JSONFirewallPolicyLookupOutput contains the output results for Lookup API function
+// PolicyID is 0 when no policy matches and the traffic hits the implicit deny policy
+type JSONFirewallPolicyLookupOutput struct {
+	Success  bool    `json:"success"`
+	PolicyID float64 `json:"policy_id"`
+}
+
+// LookupFirewallPolicy API operation for FortiOS finds the firewall policy matching the specified traffic.
+// Returns the matching policy when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the diagnose firewall iprope lookup chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) LookupFirewallPolicy(params *JSONFirewallPolicyLookup) (output *JSONFirewallPolicyLookupOutput, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/monitor/firewall/policy-lookup"
+	output = &JSONFirewallPolicyLookupOutput{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	req.FillUrlParam("srcintf", params.Srcintf)
+	req.FillUrlParam("sourceip", params.Sourceip)
+	req.FillUrlParam("dest", params.Dest)
+	req.FillUrlParam("protocol", params.Protocol)
+
+	switch params.Protocol {
+	case "icmp", "1":
+		req.FillUrlParam("icmptype", strconv.Itoa(params.Icmptype))
+		req.FillUrlParam("icmpcode", strconv.Itoa(params.Icmpcode))
+	default:
+		if params.Sourceport != 0 {
+			req.FillUrlParam("sourceport", strconv.Itoa(params.Sourceport))
+		}
+		if params.Destport != 0 {
+			req.FillUrlParam("destport", strconv.Itoa(params.Destport))
+		}
+	}
+
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	mapTmp, _ := result["results"].(map[string]interface{})
+	if mapTmp == nil {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	if mapTmp["success"] != nil {
+		output.Success = mapTmp["success"].(bool)
+	}
+	if mapTmp["policy_id"] != nil {
+		output.PolicyID = mapTmp["policy_id"].(float64)
+	}
+
+	return
+}
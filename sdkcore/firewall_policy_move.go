@@ -0,0 +1,59 @@
+package forticlient
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// movePolicy moves the policy srcId of the table before or after the policy dstId
+func (c *FortiSDKClient) movePolicy(table string, srcId, dstId int, alterPos string) (err error) {
+	if alterPos != "before" && alterPos != "after" {
+		err = fmt.Errorf("alter position must be before or after, got %q", alterPos)
+		return
+	}
+
+	HTTPMethod := "PUT"
+	path := cmdbPath(table, strconv.Itoa(srcId))
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	req.FillUrlParams(dstId, alterPos)
+	_, err = c.sendRequest(req)
+
+	return
+}
+
+// CreateUpdateFirewallLocalInPolicySeq API operation for FortiOS alters the specified local-in policy sequence.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) CreateUpdateFirewallLocalInPolicySeq(srcId, dstId int, alterPos string) (err error) {
+	return c.movePolicy("firewall/local-in-policy", srcId, dstId, alterPos)
+}
+
+// CreateUpdateFirewallLocalInPolicy6Seq API operation for FortiOS alters the specified IPv6 local-in policy sequence.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) CreateUpdateFirewallLocalInPolicy6Seq(srcId, dstId int, alterPos string) (err error) {
+	return c.movePolicy("firewall/local-in-policy6", srcId, dstId, alterPos)
+}
+
+// CreateUpdateFirewallDoSPolicySeq API operation for FortiOS alters the specified DoS policy sequence.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) CreateUpdateFirewallDoSPolicySeq(srcId, dstId int, alterPos string) (err error) {
+	return c.movePolicy("firewall/DoS-policy", srcId, dstId, alterPos)
+}
+
+// CreateUpdateFirewallCentralSnatMapSeq API operation for FortiOS alters the specified central SNAT map entry sequence.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) CreateUpdateFirewallCentralSnatMapSeq(srcId, dstId int, alterPos string) (err error) {
+	return c.movePolicy("firewall/central-snat-map", srcId, dstId, alterPos)
+}
+
+// CreateUpdateFirewallShapingPolicySeq API operation for FortiOS alters the specified shaping policy sequence.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) CreateUpdateFirewallShapingPolicySeq(srcId, dstId int, alterPos string) (err error) {
+	return c.movePolicy("firewall/shaping-policy", srcId, dstId, alterPos)
+}
+
+// CreateUpdateRouterPolicySeq API operation for FortiOS alters the specified policy route sequence.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) CreateUpdateRouterPolicySeq(srcId, dstId int, alterPos string) (err error) {
+	return c.movePolicy("router/policy", srcId, dstId, alterPos)
+}
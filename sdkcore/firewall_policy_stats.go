@@ -0,0 +1,130 @@
+package forticlient
+
+import (
+	"fmt"
+	"time"
+)
+
+// JSONFirewallPolicyStats contains the usage statistics of a firewall policy
+// FirstUsed and LastUsed are zero when the policy has not been hit
+// since the counters were last reset.
+type JSONFirewallPolicyStats struct {
+	PolicyID       int
+	ActiveSessions int64
+	Bytes          int64
+	Packets        int64
+	HitCount       int64
+	FirstUsed      time.Time
+	LastUsed       time.Time
+}
+
+// JSONFirewallPolicyUsage contains a firewall policy and its usage statistics
+// Stats is nil when the device reports no statistics for the policy.
+type JSONFirewallPolicyUsage struct {
+	Policy *JSONFirewallSecurityPolicy
+	Stats  *JSONFirewallPolicyStats
+	Unused bool
+}
+
+// ReadFirewallPolicyStats API operation for FortiOS gets the hit counts and traffic
+// statistics of all the firewall policies.
+// Returns the statistics when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the diagnose firewall iprope show chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallPolicyStats() (output []*JSONFirewallPolicyStats, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/monitor/firewall/policy"
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	results, ok := result["results"].([]interface{})
+	if !ok {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	output = make([]*JSONFirewallPolicyStats, 0, len(results))
+	for _, v := range results {
+		mapTmp, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		s := &JSONFirewallPolicyStats{}
+		if mapTmp["policyid"] != nil {
+			s.PolicyID = int(mapTmp["policyid"].(float64))
+		}
+		if mapTmp["active_sessions"] != nil {
+			s.ActiveSessions = int64(mapTmp["active_sessions"].(float64))
+		}
+		if mapTmp["bytes"] != nil {
+			s.Bytes = int64(mapTmp["bytes"].(float64))
+		}
+		if mapTmp["packets"] != nil {
+			s.Packets = int64(mapTmp["packets"].(float64))
+		}
+		if mapTmp["hit_count"] != nil {
+			s.HitCount = int64(mapTmp["hit_count"].(float64))
+		}
+		s.FirstUsed = unixTime(mapTmp["first_used"])
+		s.LastUsed = unixTime(mapTmp["last_used"])
+
+		output = append(output, s)
+	}
+
+	return
+}
+
+// ReadFirewallPolicyUsage gets all the firewall policies with their statistics, and flags
+// the ones not hit during the last unusedDays days.
+// The statistics are reset when the device reboots, check the uptime before deleting policies.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) ReadFirewallPolicyUsage(unusedDays int) (output []*JSONFirewallPolicyUsage, err error) {
+	policies, err := c.ListFirewallSecurityPolicies()
+	if err != nil {
+		return
+	}
+
+	stats, err := c.ReadFirewallPolicyStats()
+	if err != nil {
+		return
+	}
+
+	output = JoinFirewallPolicyUsage(policies, stats, unusedDays, time.Now())
+	return
+}
+
+// JoinFirewallPolicyUsage joins the policies with their statistics, in the order of the policies.
+// A policy is unused when it has not been hit since now minus unusedDays days,
+// or when it has no statistics.
+func JoinFirewallPolicyUsage(policies []*JSONFirewallSecurityPolicy, stats []*JSONFirewallPolicyStats, unusedDays int, now time.Time) []*JSONFirewallPolicyUsage {
+	byID := make(map[int]*JSONFirewallPolicyStats, len(stats))
+	for _, s := range stats {
+		byID[s.PolicyID] = s
+	}
+
+	since := now.AddDate(0, 0, -unusedDays)
+
+	output := make([]*JSONFirewallPolicyUsage, 0, len(policies))
+	for _, p := range policies {
+		u := &JSONFirewallPolicyUsage{Policy: p, Stats: byID[p.Policyid]}
+		u.Unused = u.Stats == nil || u.Stats.LastUsed.IsZero() || u.Stats.LastUsed.Before(since)
+		output = append(output, u)
+	}
+
+	return output
+}
+
+// unixTime converts a timestamp of the response, in seconds since the epoch,
+// returns the zero time when it is missing or 0
+func unixTime(v interface{}) time.Time {
+	f, ok := v.(float64)
+	if !ok || f == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(f), 0)
+}
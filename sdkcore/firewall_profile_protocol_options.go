@@ -0,0 +1,669 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallProfileProtocolOptions contains the parameters for Create and Update API function
+type JSONFirewallProfileProtocolOptions struct {
+	Name                  string                                  `json:"name"`
+	Comment               string                                  `json:"comment"`
+	ReplacemsgGroup       string                                  `json:"replacemsg-group"`
+	OversizeLog           string                                  `json:"oversize-log"`
+	SwitchingProtocolsLog string                                  `json:"switching-protocols-log"`
+	HTTP                  *FirewallProfileProtocolOptionsProtocol `json:"http,omitempty"`
+	Ftp                   *FirewallProfileProtocolOptionsProtocol `json:"ftp,omitempty"`
+	Imap                  *FirewallProfileProtocolOptionsProtocol `json:"imap,omitempty"`
+	Pop3                  *FirewallProfileProtocolOptionsProtocol `json:"pop3,omitempty"`
+	Smtp                  *FirewallProfileProtocolOptionsProtocol `json:"smtp,omitempty"`
+	Nntp                  *FirewallProfileProtocolOptionsProtocol `json:"nntp,omitempty"`
+	Mapi                  *FirewallProfileProtocolOptionsProtocol `json:"mapi,omitempty"`
+	DNS                   *FirewallProfileProtocolOptionsProtocol `json:"dns,omitempty"`
+	Cifs                  *FirewallProfileProtocolOptionsProtocol `json:"cifs,omitempty"`
+}
+
+// FirewallProfileProtocolOptionsProtocol contains the parameters of a protocol of a protocol options profile
+type FirewallProfileProtocolOptionsProtocol struct {
+	Ports                     string `json:"ports,omitempty"`
+	Status                    string `json:"status,omitempty"`
+	InspectAll                string `json:"inspect-all,omitempty"`
+	Options                   string `json:"options,omitempty"`
+	OversizeLimit             int    `json:"oversize-limit,omitempty"`
+	UncompressedOversizeLimit int    `json:"uncompressed-oversize-limit,omitempty"`
+	UncompressedNestLimit     int    `json:"uncompressed-nest-limit,omitempty"`
+	ScanBzip2                 string `json:"scan-bzip2,omitempty"`
+	ProxyAfterTCPHandshake    string `json:"proxy-after-tcp-handshake,omitempty"`
+}
+
+// JSONCreateFirewallProfileProtocolOptionsOutput contains the output results for Create API function
+type JSONCreateFirewallProfileProtocolOptionsOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallProfileProtocolOptionsOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateFirewallProfileProtocolOptionsOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallProfileProtocolOptions API operation for FortiOS creates a new protocol options profile for firewall policies.
+// Returns the index value of the protocol options profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - profile-protocol-options chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallProfileProtocolOptions(params *JSONFirewallProfileProtocolOptions) (output *JSONCreateFirewallProfileProtocolOptionsOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/profile-protocol-options"
+	output = &JSONCreateFirewallProfileProtocolOptionsOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallProfileProtocolOptions API operation for FortiOS updates the specified protocol options profile for firewall policies.
+// Returns the index value of the protocol options profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - profile-protocol-options chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallProfileProtocolOptions(params *JSONFirewallProfileProtocolOptions, mkey string) (output *JSONUpdateFirewallProfileProtocolOptionsOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/profile-protocol-options"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateFirewallProfileProtocolOptionsOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallProfileProtocolOptions API operation for FortiOS deletes the specified protocol options profile for firewall policies.
+// Returns error for service API and SDK errors.
+// See the firewall - profile-protocol-options chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallProfileProtocolOptions(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/profile-protocol-options"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallProfileProtocolOptions API operation for FortiOS gets the protocol options profile for firewall policies
+// with the specified index value.
+// Returns the requested protocol options profile value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - profile-protocol-options chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallProfileProtocolOptions(mkey string) (output *JSONFirewallProfileProtocolOptions, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/profile-protocol-options"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallProfileProtocolOptions{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallProfileProtocolOptions(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallProfileProtocolOptions API operation for FortiOS gets all the protocol options profiles for firewall policies.
+// Returns the protocol options profiles when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - profile-protocol-options chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallProfileProtocolOptions() (output []*JSONFirewallProfileProtocolOptions, err error) {
+	results, err := c.listCmdbTable("firewall/profile-protocol-options")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallProfileProtocolOptions, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallProfileProtocolOptions{}
+		fillFirewallProfileProtocolOptions(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallProfileProtocolOptions fills output from a protocol options profile of the response
+func fillFirewallProfileProtocolOptions(output *JSONFirewallProfileProtocolOptions, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["replacemsg-group"] != nil {
+		output.ReplacemsgGroup = mapTmp["replacemsg-group"].(string)
+	}
+	if mapTmp["oversize-log"] != nil {
+		output.OversizeLog = mapTmp["oversize-log"].(string)
+	}
+	if mapTmp["switching-protocols-log"] != nil {
+		output.SwitchingProtocolsLog = mapTmp["switching-protocols-log"].(string)
+	}
+	if mapTmp["http"] != nil {
+		c := mapTmp["http"].(map[string]interface{})
+		m := &FirewallProfileProtocolOptionsProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["oversize-limit"] != nil {
+			m.OversizeLimit = int(c["oversize-limit"].(float64))
+		}
+		if c["uncompressed-oversize-limit"] != nil {
+			m.UncompressedOversizeLimit = int(c["uncompressed-oversize-limit"].(float64))
+		}
+		if c["uncompressed-nest-limit"] != nil {
+			m.UncompressedNestLimit = int(c["uncompressed-nest-limit"].(float64))
+		}
+		if c["scan-bzip2"] != nil {
+			m.ScanBzip2 = c["scan-bzip2"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		output.HTTP = m
+	}
+	if mapTmp["ftp"] != nil {
+		c := mapTmp["ftp"].(map[string]interface{})
+		m := &FirewallProfileProtocolOptionsProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["oversize-limit"] != nil {
+			m.OversizeLimit = int(c["oversize-limit"].(float64))
+		}
+		if c["uncompressed-oversize-limit"] != nil {
+			m.UncompressedOversizeLimit = int(c["uncompressed-oversize-limit"].(float64))
+		}
+		if c["uncompressed-nest-limit"] != nil {
+			m.UncompressedNestLimit = int(c["uncompressed-nest-limit"].(float64))
+		}
+		if c["scan-bzip2"] != nil {
+			m.ScanBzip2 = c["scan-bzip2"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		output.Ftp = m
+	}
+	if mapTmp["imap"] != nil {
+		c := mapTmp["imap"].(map[string]interface{})
+		m := &FirewallProfileProtocolOptionsProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["oversize-limit"] != nil {
+			m.OversizeLimit = int(c["oversize-limit"].(float64))
+		}
+		if c["uncompressed-oversize-limit"] != nil {
+			m.UncompressedOversizeLimit = int(c["uncompressed-oversize-limit"].(float64))
+		}
+		if c["uncompressed-nest-limit"] != nil {
+			m.UncompressedNestLimit = int(c["uncompressed-nest-limit"].(float64))
+		}
+		if c["scan-bzip2"] != nil {
+			m.ScanBzip2 = c["scan-bzip2"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		output.Imap = m
+	}
+	if mapTmp["pop3"] != nil {
+		c := mapTmp["pop3"].(map[string]interface{})
+		m := &FirewallProfileProtocolOptionsProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["oversize-limit"] != nil {
+			m.OversizeLimit = int(c["oversize-limit"].(float64))
+		}
+		if c["uncompressed-oversize-limit"] != nil {
+			m.UncompressedOversizeLimit = int(c["uncompressed-oversize-limit"].(float64))
+		}
+		if c["uncompressed-nest-limit"] != nil {
+			m.UncompressedNestLimit = int(c["uncompressed-nest-limit"].(float64))
+		}
+		if c["scan-bzip2"] != nil {
+			m.ScanBzip2 = c["scan-bzip2"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		output.Pop3 = m
+	}
+	if mapTmp["smtp"] != nil {
+		c := mapTmp["smtp"].(map[string]interface{})
+		m := &FirewallProfileProtocolOptionsProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["oversize-limit"] != nil {
+			m.OversizeLimit = int(c["oversize-limit"].(float64))
+		}
+		if c["uncompressed-oversize-limit"] != nil {
+			m.UncompressedOversizeLimit = int(c["uncompressed-oversize-limit"].(float64))
+		}
+		if c["uncompressed-nest-limit"] != nil {
+			m.UncompressedNestLimit = int(c["uncompressed-nest-limit"].(float64))
+		}
+		if c["scan-bzip2"] != nil {
+			m.ScanBzip2 = c["scan-bzip2"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		output.Smtp = m
+	}
+	if mapTmp["nntp"] != nil {
+		c := mapTmp["nntp"].(map[string]interface{})
+		m := &FirewallProfileProtocolOptionsProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["oversize-limit"] != nil {
+			m.OversizeLimit = int(c["oversize-limit"].(float64))
+		}
+		if c["uncompressed-oversize-limit"] != nil {
+			m.UncompressedOversizeLimit = int(c["uncompressed-oversize-limit"].(float64))
+		}
+		if c["uncompressed-nest-limit"] != nil {
+			m.UncompressedNestLimit = int(c["uncompressed-nest-limit"].(float64))
+		}
+		if c["scan-bzip2"] != nil {
+			m.ScanBzip2 = c["scan-bzip2"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		output.Nntp = m
+	}
+	if mapTmp["mapi"] != nil {
+		c := mapTmp["mapi"].(map[string]interface{})
+		m := &FirewallProfileProtocolOptionsProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["oversize-limit"] != nil {
+			m.OversizeLimit = int(c["oversize-limit"].(float64))
+		}
+		if c["uncompressed-oversize-limit"] != nil {
+			m.UncompressedOversizeLimit = int(c["uncompressed-oversize-limit"].(float64))
+		}
+		if c["uncompressed-nest-limit"] != nil {
+			m.UncompressedNestLimit = int(c["uncompressed-nest-limit"].(float64))
+		}
+		if c["scan-bzip2"] != nil {
+			m.ScanBzip2 = c["scan-bzip2"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		output.Mapi = m
+	}
+	if mapTmp["dns"] != nil {
+		c := mapTmp["dns"].(map[string]interface{})
+		m := &FirewallProfileProtocolOptionsProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["oversize-limit"] != nil {
+			m.OversizeLimit = int(c["oversize-limit"].(float64))
+		}
+		if c["uncompressed-oversize-limit"] != nil {
+			m.UncompressedOversizeLimit = int(c["uncompressed-oversize-limit"].(float64))
+		}
+		if c["uncompressed-nest-limit"] != nil {
+			m.UncompressedNestLimit = int(c["uncompressed-nest-limit"].(float64))
+		}
+		if c["scan-bzip2"] != nil {
+			m.ScanBzip2 = c["scan-bzip2"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		output.DNS = m
+	}
+	if mapTmp["cifs"] != nil {
+		c := mapTmp["cifs"].(map[string]interface{})
+		m := &FirewallProfileProtocolOptionsProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["oversize-limit"] != nil {
+			m.OversizeLimit = int(c["oversize-limit"].(float64))
+		}
+		if c["uncompressed-oversize-limit"] != nil {
+			m.UncompressedOversizeLimit = int(c["uncompressed-oversize-limit"].(float64))
+		}
+		if c["uncompressed-nest-limit"] != nil {
+			m.UncompressedNestLimit = int(c["uncompressed-nest-limit"].(float64))
+		}
+		if c["scan-bzip2"] != nil {
+			m.ScanBzip2 = c["scan-bzip2"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		output.Cifs = m
+	}
+}
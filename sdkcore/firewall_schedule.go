@@ -0,0 +1,202 @@
+package forticlient
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// scheduleTimeLayout is the FortiOS format of the one-time schedule start and end
+const scheduleTimeLayout = "15:04 2006/01/02"
+
+// ScheduleTime is the start or end of a one-time schedule.
+// FortiOS stores the wall clock time of the device, without time zone,
+// the time is parsed in UTC and only its wall clock is meaningful.
+type ScheduleTime struct {
+	time.Time
+}
+
+// MarshalText formats the time as "15:04 2006/01/02"
+func (t ScheduleTime) MarshalText() ([]byte, error) {
+	return []byte(t.Format(scheduleTimeLayout)), nil
+}
+
+// UnmarshalText parses a time in the "15:04 2006/01/02" format
+func (t *ScheduleTime) UnmarshalText(b []byte) error {
+	v, err := time.Parse(scheduleTimeLayout, strings.TrimSpace(string(b)))
+	if err != nil {
+		return err
+	}
+	t.Time = v
+	return nil
+}
+
+// MarshalJSON formats the time the way MarshalText does, it replaces the format of time.Time
+func (t ScheduleTime) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + t.Format(scheduleTimeLayout) + `"`), nil
+}
+
+// UnmarshalJSON parses the time the way UnmarshalText does
+func (t *ScheduleTime) UnmarshalJSON(b []byte) error {
+	return t.UnmarshalText([]byte(strings.Trim(string(b), `"`)))
+}
+
+// wallClock returns the wall clock of t as a time in UTC
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// TimeOfDay is the start or end of a recurring schedule
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+// MarshalText formats the time of day as "15:04"
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)), nil
+}
+
+// UnmarshalText parses a time of day in the "15:04" format
+func (t *TimeOfDay) UnmarshalText(b []byte) error {
+	v, err := time.Parse("15:04", strings.TrimSpace(string(b)))
+	if err != nil {
+		return err
+	}
+	t.Hour, t.Minute = v.Hour(), v.Minute()
+	return nil
+}
+
+// minutes returns the number of minutes since midnight
+func (t TimeOfDay) minutes() int {
+	return t.Hour*60 + t.Minute
+}
+
+// ScheduleDays is the list of days of a recurring schedule
+type ScheduleDays []time.Weekday
+
+// MarshalText formats the days as "monday tuesday", or "none" when there is no day
+func (d ScheduleDays) MarshalText() ([]byte, error) {
+	if len(d) == 0 {
+		return []byte("none"), nil
+	}
+
+	names := make([]string, 0, len(d))
+	for _, w := range d {
+		names = append(names, strings.ToLower(w.String()))
+	}
+	return []byte(strings.Join(names, " ")), nil
+}
+
+// UnmarshalText parses a list of days such as "monday tuesday"
+func (d *ScheduleDays) UnmarshalText(b []byte) error {
+	days := ScheduleDays{}
+
+	for _, f := range strings.Fields(string(b)) {
+		if f == "none" {
+			continue
+		}
+
+		found := false
+		for w := time.Sunday; w <= time.Saturday; w++ {
+			if strings.EqualFold(f, w.String()) {
+				days = append(days, w)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid day %q", f)
+		}
+	}
+
+	*d = days
+	return nil
+}
+
+// contains reports whether the day is in the list
+func (d ScheduleDays) contains(w time.Weekday) bool {
+	for _, v := range d {
+		if v == w {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveAt reports whether the one-time schedule is active at the wall clock time of t,
+// t must be in the time zone of the device
+func (s *JSONFirewallScheduleOnetime) ActiveAt(t time.Time) bool {
+	w := wallClock(t)
+	return !w.Before(s.Start.Time) && !w.After(s.End.Time)
+}
+
+// ActiveAt reports whether the recurring schedule is active at the wall clock time of t,
+// t must be in the time zone of the device.
+// A schedule ending before it starts runs until the end time of the next day,
+// and a schedule with the same start and end runs the whole day.
+func (s *JSONFirewallScheduleRecurring) ActiveAt(t time.Time) bool {
+	now := t.Hour()*60 + t.Minute()
+	start, end := s.Start.minutes(), s.End.minutes()
+	day := t.Weekday()
+
+	switch {
+	case start < end:
+		return s.Day.contains(day) && now >= start && now < end
+	case start == end:
+		return s.Day.contains(day)
+	}
+
+	previous := (day + 6) % 7
+	return (s.Day.contains(day) && now >= start) || (s.Day.contains(previous) && now < end)
+}
+
+// ScheduleActive checks whether the schedule with the specified name is active at the
+// wall clock time of t, t must be in the time zone of the device.
+// The name can be a recurring schedule, a one-time schedule or a schedule group,
+// "always" is always active.
+// Returns error for an unknown schedule, and for service API and SDK errors.
+func (c *FortiSDKClient) ScheduleActive(name string, t time.Time) (bool, error) {
+	return c.scheduleActive(name, t, map[string]bool{})
+}
+
+// scheduleActive checks the schedule, seen detects the group loops
+func (c *FortiSDKClient) scheduleActive(name string, t time.Time, seen map[string]bool) (bool, error) {
+	recurring, err := c.ReadFirewallScheduleRecurring(name)
+	if err != nil {
+		return false, err
+	}
+	if recurring != nil {
+		return recurring.ActiveAt(t), nil
+	}
+
+	onetime, err := c.ReadFirewallScheduleOnetime(name)
+	if err != nil {
+		return false, err
+	}
+	if onetime != nil {
+		return onetime.ActiveAt(t), nil
+	}
+
+	group, err := c.ReadFirewallScheduleGroup(name)
+	if err != nil {
+		return false, err
+	}
+	if group == nil {
+		return false, fmt.Errorf("schedule %s not found", name)
+	}
+
+	if seen[name] {
+		return false, fmt.Errorf("schedule group %s contains itself", name)
+	}
+	seen[name] = true
+
+	for _, m := range group.Member {
+		active, err := c.scheduleActive(m.Name, t, seen)
+		if err != nil || active {
+			return active, err
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,376 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallScheduleOnetime contains the parameters for Create and Update API function
+type JSONFirewallScheduleOnetime struct {
+	Name           string       `json:"name"`
+	Start          ScheduleTime `json:"start"`
+	End            ScheduleTime `json:"end"`
+	ExpirationDays int          `json:"expiration-days"`
+	Color          int          `json:"color"`
+}
+
+// JSONCreateFirewallScheduleOnetimeOutput contains the output results for Create API function
+type JSONCreateFirewallScheduleOnetimeOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallScheduleOnetimeOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateFirewallScheduleOnetimeOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallScheduleOnetime API operation for FortiOS creates a new one-time schedule for firewall policies.
+// Returns the index value of the one-time schedule and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - schedule onetime chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallScheduleOnetime(params *JSONFirewallScheduleOnetime) (output *JSONCreateFirewallScheduleOnetimeOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall.schedule/onetime"
+	output = &JSONCreateFirewallScheduleOnetimeOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallScheduleOnetime API operation for FortiOS updates the specified one-time schedule for firewall policies.
+// Returns the index value of the one-time schedule and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - schedule onetime chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallScheduleOnetime(params *JSONFirewallScheduleOnetime, mkey string) (output *JSONUpdateFirewallScheduleOnetimeOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall.schedule/onetime"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateFirewallScheduleOnetimeOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallScheduleOnetime API operation for FortiOS deletes the specified one-time schedule for firewall policies.
+// Returns error for service API and SDK errors.
+// See the firewall - schedule onetime chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallScheduleOnetime(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall.schedule/onetime"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallScheduleOnetime API operation for FortiOS gets the one-time schedule for firewall policies
+// with the specified index value.
+// Returns the requested one-time schedule value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - schedule onetime chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallScheduleOnetime(mkey string) (output *JSONFirewallScheduleOnetime, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall.schedule/onetime"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallScheduleOnetime{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		err = fillFirewallScheduleOnetime(output, mapTmp)
+		if err != nil {
+			output = nil
+			return
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallScheduleOnetimes API operation for FortiOS gets all the one-time schedules for firewall policies.
+// Returns the one-time schedules when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - schedule onetime chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallScheduleOnetimes() (output []*JSONFirewallScheduleOnetime, err error) {
+	results, err := c.listCmdbTable("firewall.schedule/onetime")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallScheduleOnetime, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallScheduleOnetime{}
+		err = fillFirewallScheduleOnetime(v, mapTmp)
+		if err != nil {
+			output = nil
+			return
+		}
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallScheduleOnetime fills output from a one-time schedule of the response.
+// Returns error when the device returns a day or a time in an unexpected format.
+func fillFirewallScheduleOnetime(output *JSONFirewallScheduleOnetime, mapTmp map[string]interface{}) (err error) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["start"] != nil {
+		err = output.Start.UnmarshalText([]byte(mapTmp["start"].(string)))
+		if err != nil {
+			return fmt.Errorf("one-time schedule %s: invalid start %q: %s", output.Name, mapTmp["start"], err)
+		}
+	}
+	if mapTmp["end"] != nil {
+		err = output.End.UnmarshalText([]byte(mapTmp["end"].(string)))
+		if err != nil {
+			return fmt.Errorf("one-time schedule %s: invalid end %q: %s", output.Name, mapTmp["end"], err)
+		}
+	}
+	if mapTmp["expiration-days"] != nil {
+		output.ExpirationDays = int(mapTmp["expiration-days"].(float64))
+	}
+	if mapTmp["color"] != nil {
+		output.Color = int(mapTmp["color"].(float64))
+	}
+
+	return
+}
@@ -12,11 +12,16 @@ import (
 
 // JSONFirewallSecurityPolicy contains the parameters for Create and Update API function
 type JSONFirewallSecurityPolicy struct {
+	// Policyid is filled by Read and List. Create uses it as the fixed index value
+	// of the new policy when it is not 0.
+	Policyid               int                        `json:"policyid,omitempty"`
 	Name                   string                     `json:"name"`
 	Srcintf                MultValues                 `json:"srcintf"`
 	Dstintf                MultValues                 `json:"dstintf"`
 	Srcaddr                MultValues                 `json:"srcaddr"`
 	Dstaddr                MultValues                 `json:"dstaddr"`
+	Srcaddr6               MultValues                 `json:"srcaddr6,omitempty"`
+	Dstaddr6               MultValues                 `json:"dstaddr6,omitempty"`
 	InternetService        string                     `json:"internet-service"`
 	InternetServiceID      PolicyInternetIDMultValues `json:"internet-service-id"`
 	InternetServiceSrc     string                     `json:"internet-service-src"`
@@ -63,8 +68,11 @@ type JSONUpdateFirewallSecurityPolicyOutput struct {
 }
 
 // PolicyInternetIDMultValue contains the output results for Read API function
+// Name is the name of the Internet Service: Create and Update resolve it to the ID
+// when ID is 0, Read and List fill it from the ID.
 type PolicyInternetIDMultValue struct {
-	ID float64 `json:"id"`
+	ID   float64 `json:"id"`
+	Name string  `json:"-"`
 }
 
 // PolicyInternetIDMultValues contains the output results for Read API function
@@ -82,6 +90,9 @@ func ExpandPolicyInternetIDList(members []PolicyInternetIDMultValue) []float64 {
 }
 
 // CreateFirewallSecurityPolicy API operation for FortiOS creates a new firewall policy.
+// The policy gets params.Policyid as index value when it is not 0, otherwise FortiOS picks the next free one.
+// To clone a policy returned by Read or List, set its Policyid to 0 first, or the creation fails
+// because the index value is already used.
 // Returns the index value of the firewall policy and execution result when the request executes successfully.
 // Returns error for service API and SDK errors.
 // See the firewall - policy chapter in the FortiOS Handbook - CLI Reference.
@@ -89,6 +100,12 @@ func (c *FortiSDKClient) CreateFirewallSecurityPolicy(params *JSONFirewallSecuri
 	HTTPMethod := "POST"
 	path := "/api/v2/cmdb/firewall/policy"
 	output = &JSONCreateFirewallSecurityPolicyOutput{}
+
+	params, err = c.resolveInternetServiceIDs(params)
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
@@ -162,6 +179,12 @@ func (c *FortiSDKClient) UpdateFirewallSecurityPolicy(params *JSONFirewallSecuri
 	path := "/api/v2/cmdb/firewall/policy"
 	path += "/" + mkey
 	output = &JSONUpdateFirewallSecurityPolicyOutput{}
+
+	params, err = c.resolveInternetServiceIDs(params)
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
@@ -354,226 +377,284 @@ func (c *FortiSDKClient) ReadFirewallSecurityPolicy(mkey string) (output *JSONFi
 			return
 		}
 
-		if mapTmp["name"] != nil {
-			output.Name = mapTmp["name"].(string)
-		}
-		if mapTmp["srcintf"] != nil {
-			member := mapTmp["srcintf"].([]interface{})
+		fillFirewallSecurityPolicy(output, mapTmp)
+		c.nameInternetServices(output)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
 
-			var members []MultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+	return
+}
 
-				members = append(members,
-					MultValue{
-						Name: c["name"].(string),
-					})
-			}
-			output.Srcintf = members
+// ListFirewallSecurityPolicies API operation for FortiOS gets all the firewall policies,
+// in the order they are evaluated.
+// Returns the firewall policies when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallSecurityPolicies() (output []*JSONFirewallSecurityPolicy, err error) {
+	results, err := c.listCmdbTable("firewall/policy")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallSecurityPolicy, 0, len(results))
+	for _, mapTmp := range results {
+		p := &JSONFirewallSecurityPolicy{}
+		fillFirewallSecurityPolicy(p, mapTmp)
+		output = append(output, p)
+	}
+	c.nameInternetServices(output...)
+
+	return
+}
+
+// fillFirewallSecurityPolicy fills output from a firewall policy of the response
+func fillFirewallSecurityPolicy(output *JSONFirewallSecurityPolicy, mapTmp map[string]interface{}) {
+	if mapTmp["policyid"] != nil {
+		output.Policyid = int(mapTmp["policyid"].(float64))
+	}
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["srcintf"] != nil {
+		member := mapTmp["srcintf"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["dstintf"] != nil {
-			member := mapTmp["dstintf"].([]interface{})
+		output.Srcintf = members
+	}
+	if mapTmp["dstintf"] != nil {
+		member := mapTmp["dstintf"].([]interface{})
 
-			var members []MultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					MultValue{
-						Name: c["name"].(string),
-					})
-			}
-			output.Dstintf = members
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["srcaddr"] != nil {
-			member := mapTmp["srcaddr"].([]interface{})
+		output.Dstintf = members
+	}
+	if mapTmp["srcaddr"] != nil {
+		member := mapTmp["srcaddr"].([]interface{})
 
-			var members []MultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					MultValue{
-						Name: c["name"].(string),
-					})
-			}
-			output.Srcaddr = members
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["dstaddr"] != nil {
-			member := mapTmp["dstaddr"].([]interface{})
+		output.Srcaddr = members
+	}
+	if mapTmp["dstaddr"] != nil {
+		member := mapTmp["dstaddr"].([]interface{})
 
-			var members []MultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					MultValue{
-						Name: c["name"].(string),
-					})
-			}
-			output.Dstaddr = members
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["internet-service"] != nil {
-			output.InternetService = mapTmp["internet-service"].(string)
+		output.Dstaddr = members
+	}
+	if mapTmp["srcaddr6"] != nil {
+		member := mapTmp["srcaddr6"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["internet-service-id"] != nil {
-			member := mapTmp["internet-service-id"].([]interface{})
+		output.Srcaddr6 = members
+	}
+	if mapTmp["dstaddr6"] != nil {
+		member := mapTmp["dstaddr6"].([]interface{})
 
-			var members []PolicyInternetIDMultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					PolicyInternetIDMultValue{
-						ID: c["id"].(float64),
-					})
-			}
-			output.InternetServiceID = members
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["internet-service-src"] != nil {
-			output.InternetServiceSrc = mapTmp["internet-service-src"].(string)
+		output.Dstaddr6 = members
+	}
+	if mapTmp["internet-service"] != nil {
+		output.InternetService = mapTmp["internet-service"].(string)
+	}
+	if mapTmp["internet-service-id"] != nil {
+		member := mapTmp["internet-service-id"].([]interface{})
+
+		var members []PolicyInternetIDMultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				PolicyInternetIDMultValue{
+					ID: c["id"].(float64),
+				})
 		}
-		if mapTmp["internet-service-src-id"] != nil {
-			member := mapTmp["internet-service-src-id"].([]interface{})
+		output.InternetServiceID = members
+	}
+	if mapTmp["internet-service-src"] != nil {
+		output.InternetServiceSrc = mapTmp["internet-service-src"].(string)
+	}
+	if mapTmp["internet-service-src-id"] != nil {
+		member := mapTmp["internet-service-src-id"].([]interface{})
 
-			var members []PolicyInternetIDMultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []PolicyInternetIDMultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					PolicyInternetIDMultValue{
-						ID: c["id"].(float64),
-					})
-			}
-			output.InternetServiceSrcID = members
+			members = append(members,
+				PolicyInternetIDMultValue{
+					ID: c["id"].(float64),
+				})
 		}
+		output.InternetServiceSrcID = members
+	}
 
-		if mapTmp["users"] != nil {
-			member := mapTmp["users"].([]interface{})
+	if mapTmp["users"] != nil {
+		member := mapTmp["users"].([]interface{})
 
-			var members []MultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					MultValue{
-						Name: c["name"].(string),
-					})
-			}
-			output.Users = members
-		}
-		if mapTmp["status"] != nil {
-			output.Status = mapTmp["status"].(string)
-		}
-		if mapTmp["action"] != nil {
-			output.Action = mapTmp["action"].(string)
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["schedule"] != nil {
-			output.Schedule = mapTmp["schedule"].(string)
-		}
-		if mapTmp["service"] != nil {
-			member := mapTmp["service"].([]interface{})
+		output.Users = members
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+	if mapTmp["action"] != nil {
+		output.Action = mapTmp["action"].(string)
+	}
+	if mapTmp["schedule"] != nil {
+		output.Schedule = mapTmp["schedule"].(string)
+	}
+	if mapTmp["service"] != nil {
+		member := mapTmp["service"].([]interface{})
 
-			var members []MultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					MultValue{
-						Name: c["name"].(string),
-					})
-			}
-			output.Service = members
-		}
-		if mapTmp["utm-status"] != nil {
-			output.UtmStatus = mapTmp["utm-status"].(string)
-		}
-		if mapTmp["logtraffic"] != nil {
-			output.Logtraffic = mapTmp["logtraffic"].(string)
-		}
-		if mapTmp["logtraffic-start"] != nil {
-			output.LogtrafficStart = mapTmp["logtraffic-start"].(string)
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["capture-packet"] != nil {
-			output.CapturePacket = mapTmp["capture-packet"].(string)
-		}
-		if mapTmp["ippool"] != nil {
-			output.Ippool = mapTmp["ippool"].(string)
-		}
-		if mapTmp["poolname"] != nil {
-			member := mapTmp["poolname"].([]interface{})
+		output.Service = members
+	}
+	if mapTmp["utm-status"] != nil {
+		output.UtmStatus = mapTmp["utm-status"].(string)
+	}
+	if mapTmp["logtraffic"] != nil {
+		output.Logtraffic = mapTmp["logtraffic"].(string)
+	}
+	if mapTmp["logtraffic-start"] != nil {
+		output.LogtrafficStart = mapTmp["logtraffic-start"].(string)
+	}
+	if mapTmp["capture-packet"] != nil {
+		output.CapturePacket = mapTmp["capture-packet"].(string)
+	}
+	if mapTmp["ippool"] != nil {
+		output.Ippool = mapTmp["ippool"].(string)
+	}
+	if mapTmp["poolname"] != nil {
+		member := mapTmp["poolname"].([]interface{})
 
-			var members []MultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					MultValue{
-						Name: c["name"].(string),
-					})
-			}
-			output.Poolname = members
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["groups"] != nil {
-			member := mapTmp["groups"].([]interface{})
+		output.Poolname = members
+	}
+	if mapTmp["groups"] != nil {
+		member := mapTmp["groups"].([]interface{})
 
-			var members []MultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					MultValue{
-						Name: c["name"].(string),
-					})
-			}
-			output.Groups = members
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["devices"] != nil {
-			member := mapTmp["devices"].([]interface{})
+		output.Groups = members
+	}
+	if mapTmp["devices"] != nil {
+		member := mapTmp["devices"].([]interface{})
 
-			var members []MultValue
-			for _, v := range member {
-				c := v.(map[string]interface{})
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
 
-				members = append(members,
-					MultValue{
-						Name: c["name"].(string),
-					})
-			}
-			output.Devices = members
-		}
-		if mapTmp["comments"] != nil {
-			output.Comments = mapTmp["comments"].(string)
-		}
-		if mapTmp["av-profile"] != nil {
-			output.AvProfile = mapTmp["av-profile"].(string)
-		}
-		if mapTmp["webfilter-profile"] != nil {
-			output.WebfilterProfile = mapTmp["webfilter-profile"].(string)
-		}
-		if mapTmp["dnsfilter-profile"] != nil {
-			output.DnsfilterProfile = mapTmp["dnsfilter-profile"].(string)
-		}
-		if mapTmp["ips-sensor"] != nil {
-			output.IpsSensor = mapTmp["ips-sensor"].(string)
-		}
-		if mapTmp["application-list"] != nil {
-			output.ApplicationList = mapTmp["application-list"].(string)
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
 		}
-		if mapTmp["ssl-ssh-profile"] != nil {
-			output.SslSSHProfile = mapTmp["ssl-ssh-profile"].(string)
-		}
-		if mapTmp["nat"] != nil {
-			output.Nat = mapTmp["nat"].(string)
-		}
-		if mapTmp["profile-protocol-options"] != nil {
-			output.ProfileProtocolOptions = mapTmp["profile-protocol-options"].(string)
-		}
-
-	} else {
-		err = fmt.Errorf("cannot get the right response")
-		return
+		output.Devices = members
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+	if mapTmp["av-profile"] != nil {
+		output.AvProfile = mapTmp["av-profile"].(string)
+	}
+	if mapTmp["webfilter-profile"] != nil {
+		output.WebfilterProfile = mapTmp["webfilter-profile"].(string)
+	}
+	if mapTmp["dnsfilter-profile"] != nil {
+		output.DnsfilterProfile = mapTmp["dnsfilter-profile"].(string)
+	}
+	if mapTmp["ips-sensor"] != nil {
+		output.IpsSensor = mapTmp["ips-sensor"].(string)
+	}
+	if mapTmp["application-list"] != nil {
+		output.ApplicationList = mapTmp["application-list"].(string)
+	}
+	if mapTmp["ssl-ssh-profile"] != nil {
+		output.SslSSHProfile = mapTmp["ssl-ssh-profile"].(string)
+	}
+	if mapTmp["nat"] != nil {
+		output.Nat = mapTmp["nat"].(string)
+	}
+	if mapTmp["profile-protocol-options"] != nil {
+		output.ProfileProtocolOptions = mapTmp["profile-protocol-options"].(string)
 	}
-
-	return
 }
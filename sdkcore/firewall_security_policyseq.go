@@ -76,3 +76,221 @@ func (c *FortiSDKClient) ReadFirewallSecurityPolicySeq() (err error) {
 func (c *FortiSDKClient) DelFirewallSecurityPolicySeq() (err error) {
 	return
 }
+
+// JSONPolicyMove describes the move of a firewall policy before or after another one
+type JSONPolicyMove struct {
+	PolicyID int    `json:"policyid"`
+	AlterPos string `json:"alter_position"`
+	DstID    int    `json:"policy_dst_id"`
+}
+
+// ReadPolicyOrder API operation for FortiOS gets the index values of all the firewall policies
+// in the order FortiOS evaluates them.
+// Returns the ordered index values when the request executes successfully.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) ReadPolicyOrder() (output []int, err error) {
+	return c.readPolicyOrder("firewall/policy", "policyid")
+}
+
+// ReorderPolicies API operation for FortiOS moves the firewall policies into the desired order,
+// using the minimum number of moves, then reads the order back to verify it.
+// desired must contain the index values of all the firewall policies.
+// Returns the applied moves when the request executes successfully.
+// Returns error for service API and SDK errors, and when the final order is not the desired one.
+func (c *FortiSDKClient) ReorderPolicies(desired []int) (output []JSONPolicyMove, err error) {
+	return c.reorderPolicies("firewall/policy", "policyid", desired)
+}
+
+// ReadRouterPolicyOrder API operation for FortiOS gets the sequence numbers of all the router policies
+// in the order FortiOS evaluates them.
+// Returns the ordered sequence numbers when the request executes successfully.
+// Returns error for service API and SDK errors.
+func (c *FortiSDKClient) ReadRouterPolicyOrder() (output []int, err error) {
+	return c.readPolicyOrder("router/policy", "seq-num")
+}
+
+// ReorderRouterPolicies API operation for FortiOS moves the router policies into the desired order,
+// using the minimum number of moves, then reads the order back to verify it.
+// desired must contain the sequence numbers of all the router policies,
+// the PolicyID and DstID of the returned moves are sequence numbers.
+// Returns the applied moves when the request executes successfully.
+// Returns error for service API and SDK errors, and when the final order is not the desired one.
+func (c *FortiSDKClient) ReorderRouterPolicies(desired []int) (output []JSONPolicyMove, err error) {
+	return c.reorderPolicies("router/policy", "seq-num", desired)
+}
+
+// readPolicyOrder gets the key values of all the entries of the ordered table
+func (c *FortiSDKClient) readPolicyOrder(table string, key string) (output []int, err error) {
+	HTTPMethod := "GET"
+	path := cmdbPath(table, "")
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	req.FillUrlParam("format", key)
+
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	if result["results"] == nil {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	output = []int{}
+	for _, v := range result["results"].([]interface{}) {
+		mapTmp, _ := v.(map[string]interface{})
+		if mapTmp == nil || mapTmp[key] == nil {
+			err = fmt.Errorf("cannot get %s from the response", key)
+			return
+		}
+		output = append(output, int(mapTmp[key].(float64)))
+	}
+
+	return
+}
+
+// reorderPolicies moves the entries of the ordered table into the desired order and verifies it
+func (c *FortiSDKClient) reorderPolicies(table string, key string, desired []int) (output []JSONPolicyMove, err error) {
+	current, err := c.readPolicyOrder(table, key)
+	if err != nil {
+		return
+	}
+
+	moves, err := PolicyMoves(current, desired)
+	if err != nil {
+		return
+	}
+
+	output = []JSONPolicyMove{}
+	for _, m := range moves {
+		err = c.movePolicy(table, m.PolicyID, m.DstID, m.AlterPos)
+		if err != nil {
+			err = fmt.Errorf("cannot move %s %d %s %d: %s", table, m.PolicyID, m.AlterPos, m.DstID, err)
+			return
+		}
+		output = append(output, m)
+	}
+
+	final, err := c.readPolicyOrder(table, key)
+	if err != nil {
+		return
+	}
+
+	if !equalOrder(final, desired) {
+		err = fmt.Errorf("%s order is %v after the moves instead of %v", table, final, desired)
+		return
+	}
+
+	return
+}
+
+// PolicyMoves computes the minimum list of moves turning the current policy order into the desired one.
+// The policies on a longest common subsequence of both orders stay in place,
+// every other policy is moved after its predecessor in the desired order.
+// Returns error when both orders do not contain the same policies.
+func PolicyMoves(current []int, desired []int) (moves []JSONPolicyMove, err error) {
+	moves = []JSONPolicyMove{}
+
+	if len(current) != len(desired) {
+		err = fmt.Errorf("desired order has %d policies, the device has %d", len(desired), len(current))
+		return
+	}
+
+	index := make(map[int]int, len(desired))
+	for i, id := range desired {
+		if _, ok := index[id]; ok {
+			err = fmt.Errorf("policy %d appears twice in the desired order", id)
+			return
+		}
+		index[id] = i
+	}
+
+	seq := make([]int, len(current))
+	for i, id := range current {
+		pos, ok := index[id]
+		if !ok {
+			err = fmt.Errorf("policy %d is missing from the desired order", id)
+			return
+		}
+		seq[i] = pos
+	}
+
+	// policies which keep their place, as positions in the desired order
+	keep := make(map[int]bool)
+	for _, pos := range longestIncreasing(seq) {
+		keep[pos] = true
+	}
+
+	for i, id := range desired {
+		if keep[i] {
+			continue
+		}
+
+		if i == 0 {
+			if current[0] != id {
+				moves = append(moves, JSONPolicyMove{PolicyID: id, AlterPos: "before", DstID: current[0]})
+			}
+			continue
+		}
+
+		moves = append(moves, JSONPolicyMove{PolicyID: id, AlterPos: "after", DstID: desired[i-1]})
+	}
+
+	return
+}
+
+// longestIncreasing returns a longest strictly increasing subsequence of seq
+func longestIncreasing(seq []int) []int {
+	// tails[k] is the index in seq of the smallest tail of the increasing subsequences of length k+1
+	tails := []int{}
+	prev := make([]int, len(seq))
+
+	for i, v := range seq {
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if seq[tails[mid]] < v {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+
+		if lo > 0 {
+			prev[i] = tails[lo-1]
+		} else {
+			prev[i] = -1
+		}
+
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+
+	out := make([]int, len(tails))
+	if len(tails) == 0 {
+		return out
+	}
+
+	for i, k := len(tails)-1, tails[len(tails)-1]; i >= 0; i, k = i-1, prev[k] {
+		out[i] = seq[k]
+	}
+
+	return out
+}
+
+// equalOrder reports whether a and b hold the same index values in the same order
+func equalOrder(a []int, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,205 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+)
+
+// applyMoves applies the moves to order the way FortiOS does
+func applyMoves(t *testing.T, order []int, moves []JSONPolicyMove) []int {
+	out := append([]int(nil), order...)
+
+	for _, m := range moves {
+		src := -1
+		for i, id := range out {
+			if id == m.PolicyID {
+				src = i
+			}
+		}
+		if src < 0 {
+			t.Fatalf("move of unknown policy %d", m.PolicyID)
+		}
+		out = append(out[:src], out[src+1:]...)
+
+		dst := -1
+		for i, id := range out {
+			if id == m.DstID {
+				dst = i
+			}
+		}
+		if dst < 0 {
+			t.Fatalf("move %s unknown policy %d", m.AlterPos, m.DstID)
+		}
+		if m.AlterPos == "after" {
+			dst++
+		}
+
+		out = append(out[:dst], append([]int{m.PolicyID}, out[dst:]...)...)
+	}
+
+	return out
+}
+
+func TestPolicyMoves(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []int
+		desired []int
+		moves   int
+	}{
+		{"empty", []int{}, []int{}, 0},
+		{"same order", []int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{"first to last", []int{1, 2, 3, 4}, []int{2, 3, 4, 1}, 1},
+		{"last to first", []int{1, 2, 3, 4}, []int{4, 1, 2, 3}, 1},
+		{"swap", []int{1, 2}, []int{2, 1}, 1},
+		{"reverse", []int{1, 2, 3, 4}, []int{4, 3, 2, 1}, 3},
+		{"interleaved", []int{5, 1, 6, 2, 7, 3}, []int{1, 2, 3, 5, 6, 7}, 3},
+		{"sparse ids", []int{10, 30, 20, 40}, []int{10, 20, 30, 40}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moves, err := PolicyMoves(tt.current, tt.desired)
+			if err != nil {
+				t.Fatalf("PolicyMoves() error = %v", err)
+			}
+			if len(moves) != tt.moves {
+				t.Errorf("PolicyMoves() = %d moves %v, want %d", len(moves), moves, tt.moves)
+			}
+			if got := applyMoves(t, tt.current, moves); !equalOrder(got, tt.desired) {
+				t.Errorf("order after moves = %v, want %v", got, tt.desired)
+			}
+		})
+	}
+}
+
+func TestPolicyMovesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []int
+		desired []int
+	}{
+		{"missing policy", []int{1, 2, 3}, []int{1, 2}},
+		{"unknown policy", []int{1, 2, 3}, []int{1, 2, 4}},
+		{"duplicate policy", []int{1, 2, 3}, []int{1, 2, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := PolicyMoves(tt.current, tt.desired); err == nil {
+				t.Errorf("PolicyMoves(%v, %v) error = nil, want error", tt.current, tt.desired)
+			}
+		})
+	}
+}
+
+func TestLongestIncreasing(t *testing.T) {
+	tests := []struct {
+		seq  []int
+		want []int
+	}{
+		{[]int{}, []int{}},
+		{[]int{3}, []int{3}},
+		{[]int{0, 1, 2}, []int{0, 1, 2}},
+		{[]int{2, 1, 0}, []int{0}},
+		{[]int{3, 0, 4, 1, 5, 2}, []int{0, 1, 2}},
+		{[]int{1, 3, 2, 4}, []int{1, 2, 4}},
+	}
+
+	for _, tt := range tests {
+		if got := longestIncreasing(tt.seq); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("longestIncreasing(%v) = %v, want %v", tt.seq, got, tt.want)
+		}
+	}
+}
+
+// fakeOrderedTable emulates the listing and the moves of an ordered cmdb table of FortiOS
+type fakeOrderedTable struct {
+	mu    sync.Mutex
+	path  string
+	key   string
+	order []int
+	moves int
+}
+
+func (d *fakeOrderedTable) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	reply := map[string]interface{}{"status": "error", "http_status": 404}
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == d.path && r.URL.Query().Get("format") == d.key:
+		results := []interface{}{}
+		for _, id := range d.order {
+			results = append(results, map[string]interface{}{d.key: id})
+		}
+		reply = map[string]interface{}{"status": "success", "http_status": 200, "results": results}
+
+	case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, d.path+"/") && r.URL.Query().Get("action") == "move":
+		src, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, d.path+"/"))
+		move := JSONPolicyMove{PolicyID: src}
+		for _, pos := range []string{"before", "after"} {
+			if v := r.URL.Query().Get(pos); v != "" {
+				move.AlterPos = pos
+				move.DstID, _ = strconv.Atoi(v)
+			}
+		}
+		d.order = applyMoves(nil, d.order, []JSONPolicyMove{move})
+		d.moves++
+		reply = map[string]interface{}{"status": "success", "http_status": 200}
+	}
+
+	json.NewEncoder(w).Encode(reply)
+}
+
+func TestReorderPolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		key     string
+		reorder func(c *FortiSDKClient, desired []int) ([]JSONPolicyMove, error)
+	}{
+		{"firewall policies", "/api/v2/cmdb/firewall/policy", "policyid", (*FortiSDKClient).ReorderPolicies},
+		{"router policies", "/api/v2/cmdb/router/policy", "seq-num", (*FortiSDKClient).ReorderRouterPolicies},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := &fakeOrderedTable{path: tt.path, key: tt.key, order: []int{1, 2, 3, 4}}
+			device := httptest.NewTLSServer(table)
+			defer device.Close()
+
+			u, err := url.Parse(device.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client())
+
+			desired := []int{4, 1, 3, 2}
+			moves, err := tt.reorder(c, desired)
+			if err != nil {
+				t.Fatalf("reorder error = %v", err)
+			}
+			if !equalOrder(table.order, desired) {
+				t.Errorf("order = %v, want %v", table.order, desired)
+			}
+			if len(moves) != table.moves || table.moves != 2 {
+				t.Errorf("moves = %v, device applied %d, want 2", moves, table.moves)
+			}
+
+			if _, err := tt.reorder(c, []int{1, 2}); err == nil {
+				t.Error("reorder error = nil, want error for a partial order")
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package forticlient
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Bandwidth is a traffic rate in kbps
+type Bandwidth int64
+
+// Bandwidth units
+const (
+	Kbps Bandwidth = 1
+	Mbps           = 1000 * Kbps
+	Gbps           = 1000 * Mbps
+)
+
+// ParseBandwidth parses a rate such as "512kbps", "100 Mbps" or "1.5Gbps",
+// a rate without unit is in kbps
+func ParseBandwidth(s string) (Bandwidth, error) {
+	v := strings.ToLower(strings.TrimSpace(s))
+
+	unit := Kbps
+	for _, u := range []string{"kbps", "mbps", "gbps"} {
+		if strings.HasSuffix(v, u) {
+			unit, _ = bandwidthUnit(u)
+			v = strings.TrimSpace(strings.TrimSuffix(v, u))
+			break
+		}
+	}
+
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid bandwidth %q", s)
+	}
+
+	kbps := f * float64(unit)
+	if kbps != math.Trunc(kbps) {
+		return 0, fmt.Errorf("bandwidth %q is not a whole number of kbps", s)
+	}
+	return Bandwidth(kbps), nil
+}
+
+// Kbps returns the rate in kbps
+func (b Bandwidth) Kbps() int64 {
+	return int64(b)
+}
+
+// Mbps returns the rate in Mbps
+func (b Bandwidth) Mbps() float64 {
+	return float64(b) / float64(Mbps)
+}
+
+// String formats the rate in the largest unit holding it exactly, such as "100 Mbps"
+func (b Bandwidth) String() string {
+	switch {
+	case b != 0 && b%Gbps == 0:
+		return strconv.FormatInt(int64(b/Gbps), 10) + " Gbps"
+	case b != 0 && b%Mbps == 0:
+		return strconv.FormatInt(int64(b/Mbps), 10) + " Mbps"
+	}
+	return strconv.FormatInt(int64(b), 10) + " kbps"
+}
+
+// bandwidthUnit returns the rate of one FortiOS bandwidth-unit, the empty unit is kbps
+func bandwidthUnit(unit string) (Bandwidth, error) {
+	switch unit {
+	case "", "kbps":
+		return Kbps, nil
+	case "mbps":
+		return Mbps, nil
+	case "gbps":
+		return Gbps, nil
+	}
+	return 0, fmt.Errorf("invalid bandwidth unit %q", unit)
+}
+
+// bandwidthIn converts a FortiOS value in the bandwidth-unit to a rate
+func bandwidthIn(v int, unit string) (Bandwidth, error) {
+	u, err := bandwidthUnit(unit)
+	if err != nil {
+		return 0, err
+	}
+	return Bandwidth(v) * u, nil
+}
+
+// commonUnit returns the largest bandwidth-unit holding all the rates exactly, and the rates in this unit.
+// The unit is kbps when all the rates are 0.
+func commonUnit(rates ...Bandwidth) (unit string, values []int) {
+	unit = "kbps"
+	for _, u := range []string{"gbps", "mbps"} {
+		r, _ := bandwidthUnit(u)
+		exact, nonzero := true, false
+		for _, b := range rates {
+			if b%r != 0 {
+				exact = false
+			}
+			if b != 0 {
+				nonzero = true
+			}
+		}
+		if exact && nonzero {
+			unit = u
+			break
+		}
+	}
+
+	r, _ := bandwidthUnit(unit)
+	for _, b := range rates {
+		values = append(values, int(b/r))
+	}
+	return
+}
+
+// Guaranteed returns the guaranteed bandwidth of the shaper
+func (s *JSONFirewallShaperTrafficShaper) Guaranteed() (Bandwidth, error) {
+	return bandwidthIn(s.GuaranteedBandwidth, s.BandwidthUnit)
+}
+
+// Maximum returns the maximum bandwidth of the shaper, 0 is unlimited
+func (s *JSONFirewallShaperTrafficShaper) Maximum() (Bandwidth, error) {
+	return bandwidthIn(s.MaximumBandwidth, s.BandwidthUnit)
+}
+
+// SetBandwidth sets the guaranteed and the maximum bandwidth of the shaper,
+// in the largest unit holding both exactly
+func (s *JSONFirewallShaperTrafficShaper) SetBandwidth(guaranteed Bandwidth, maximum Bandwidth) {
+	unit, values := commonUnit(guaranteed, maximum)
+	s.BandwidthUnit = unit
+	s.GuaranteedBandwidth, s.MaximumBandwidth = values[0], values[1]
+}
+
+// normalize returns a copy of the shaper after checking its unit, its priority
+// and that the guaranteed bandwidth does not exceed the maximum bandwidth
+func (s *JSONFirewallShaperTrafficShaper) normalize() (*JSONFirewallShaperTrafficShaper, error) {
+	n := *s
+
+	guaranteed, err := n.Guaranteed()
+	if err != nil {
+		return nil, fmt.Errorf("traffic shaper %s: %s", n.Name, err)
+	}
+	maximum, _ := n.Maximum()
+
+	if guaranteed < 0 || maximum < 0 {
+		return nil, fmt.Errorf("traffic shaper %s: bandwidth cannot be negative", n.Name)
+	}
+	if maximum != 0 && guaranteed > maximum {
+		return nil, fmt.Errorf("traffic shaper %s: guaranteed bandwidth %s exceeds maximum bandwidth %s", n.Name, guaranteed, maximum)
+	}
+
+	switch n.Priority {
+	case "", "low", "medium", "high":
+	default:
+		return nil, fmt.Errorf("traffic shaper %s: invalid priority %q", n.Name, n.Priority)
+	}
+
+	return &n, nil
+}
+
+// Maximum returns the maximum bandwidth of each source address, 0 is unlimited
+func (s *JSONFirewallShaperPerIPShaper) Maximum() (Bandwidth, error) {
+	return bandwidthIn(s.MaxBandwidth, s.BandwidthUnit)
+}
+
+// SetMaximum sets the maximum bandwidth of each source address, in the largest unit holding it exactly
+func (s *JSONFirewallShaperPerIPShaper) SetMaximum(maximum Bandwidth) {
+	unit, values := commonUnit(maximum)
+	s.BandwidthUnit = unit
+	s.MaxBandwidth = values[0]
+}
+
+// normalize returns a copy of the shaper after checking its unit and its limits
+func (s *JSONFirewallShaperPerIPShaper) normalize() (*JSONFirewallShaperPerIPShaper, error) {
+	n := *s
+
+	maximum, err := n.Maximum()
+	if err != nil {
+		return nil, fmt.Errorf("per-IP shaper %s: %s", n.Name, err)
+	}
+	if maximum < 0 || n.MaxConcurrentSession < 0 || n.MaxConcurrentTCPSession < 0 || n.MaxConcurrentUDPSession < 0 {
+		return nil, fmt.Errorf("per-IP shaper %s: limits cannot be negative", n.Name)
+	}
+
+	return &n, nil
+}
@@ -0,0 +1,145 @@
+package forticlient
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseBandwidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Bandwidth
+		err  bool
+	}{
+		{s: "512", want: 512},
+		{s: "512kbps", want: 512},
+		{s: "100 Mbps", want: 100 * Mbps},
+		{s: " 1.5Gbps ", want: 1500 * Mbps},
+		{s: "0.25MBPS", want: 250},
+		{s: "0", want: 0},
+		{s: "0.5kbps", err: true},
+		{s: "-1Mbps", err: true},
+		{s: "fast", err: true},
+		{s: "10 bps", err: true},
+		{s: "", err: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseBandwidth(tt.s)
+		if (err != nil) != tt.err {
+			t.Errorf("ParseBandwidth(%q) error = %v, want error %v", tt.s, err, tt.err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseBandwidth(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestBandwidthString(t *testing.T) {
+	tests := []struct {
+		b    Bandwidth
+		want string
+	}{
+		{0, "0 kbps"},
+		{512, "512 kbps"},
+		{1500, "1500 kbps"},
+		{100 * Mbps, "100 Mbps"},
+		{1500 * Mbps, "1500 Mbps"},
+		{2 * Gbps, "2 Gbps"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.b.String(); got != tt.want {
+			t.Errorf("Bandwidth(%d).String() = %q, want %q", tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCommonUnit(t *testing.T) {
+	tests := []struct {
+		rates  []Bandwidth
+		unit   string
+		values []int
+	}{
+		{[]Bandwidth{0, 0}, "kbps", []int{0, 0}},
+		{[]Bandwidth{0}, "kbps", []int{0}},
+		{[]Bandwidth{0, 2 * Gbps}, "gbps", []int{0, 2}},
+		{[]Bandwidth{Gbps, 500 * Mbps}, "mbps", []int{1000, 500}},
+		{[]Bandwidth{10 * Mbps, 1500}, "kbps", []int{10000, 1500}},
+	}
+
+	for _, tt := range tests {
+		unit, values := commonUnit(tt.rates...)
+		if unit != tt.unit || !reflect.DeepEqual(values, tt.values) {
+			t.Errorf("commonUnit(%v) = %s %v, want %s %v", tt.rates, unit, values, tt.unit, tt.values)
+		}
+	}
+}
+
+func TestTrafficShaperNormalize(t *testing.T) {
+	tests := []struct {
+		name   string
+		shaper JSONFirewallShaperTrafficShaper
+		err    bool
+	}{
+		{name: "unlimited", shaper: JSONFirewallShaperTrafficShaper{GuaranteedBandwidth: 100}},
+		{name: "guaranteed below maximum", shaper: JSONFirewallShaperTrafficShaper{GuaranteedBandwidth: 100, MaximumBandwidth: 1000, BandwidthUnit: "mbps", Priority: "high"}},
+		{name: "guaranteed above maximum", shaper: JSONFirewallShaperTrafficShaper{GuaranteedBandwidth: 2000, MaximumBandwidth: 1, BandwidthUnit: "mbps"}, err: true},
+		{name: "negative", shaper: JSONFirewallShaperTrafficShaper{GuaranteedBandwidth: -1}, err: true},
+		{name: "unit", shaper: JSONFirewallShaperTrafficShaper{BandwidthUnit: "Mbps"}, err: true},
+		{name: "priority", shaper: JSONFirewallShaperTrafficShaper{Priority: "urgent"}, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.shaper.normalize()
+			if (err != nil) != tt.err {
+				t.Fatalf("normalize() error = %v, want error %v", err, tt.err)
+			}
+			if err == nil && *got != tt.shaper {
+				t.Errorf("normalize() = %+v, want %+v", *got, tt.shaper)
+			}
+		})
+	}
+}
+
+func TestPerIPShaperNormalize(t *testing.T) {
+	tests := []struct {
+		name   string
+		shaper JSONFirewallShaperPerIPShaper
+		err    bool
+	}{
+		{name: "unlimited", shaper: JSONFirewallShaperPerIPShaper{}},
+		{name: "limits", shaper: JSONFirewallShaperPerIPShaper{MaxBandwidth: 10, BandwidthUnit: "mbps", MaxConcurrentSession: 100}},
+		{name: "negative bandwidth", shaper: JSONFirewallShaperPerIPShaper{MaxBandwidth: -1}, err: true},
+		{name: "negative sessions", shaper: JSONFirewallShaperPerIPShaper{MaxConcurrentUDPSession: -1}, err: true},
+		{name: "unit", shaper: JSONFirewallShaperPerIPShaper{BandwidthUnit: "bps"}, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.shaper.normalize()
+			if (err != nil) != tt.err {
+				t.Fatalf("normalize() error = %v, want error %v", err, tt.err)
+			}
+			if err == nil && *got != tt.shaper {
+				t.Errorf("normalize() = %+v, want %+v", *got, tt.shaper)
+			}
+		})
+	}
+}
+
+func TestSetBandwidth(t *testing.T) {
+	s := &JSONFirewallShaperTrafficShaper{BandwidthUnit: "gbps", GuaranteedBandwidth: 1, MaximumBandwidth: 2}
+	s.SetBandwidth(0, 0)
+	if s.BandwidthUnit != "kbps" || s.GuaranteedBandwidth != 0 || s.MaximumBandwidth != 0 {
+		t.Errorf("SetBandwidth(0, 0) = %s %d %d, want kbps 0 0", s.BandwidthUnit, s.GuaranteedBandwidth, s.MaximumBandwidth)
+	}
+
+	p := &JSONFirewallShaperPerIPShaper{}
+	p.SetMaximum(20 * Mbps)
+	if p.BandwidthUnit != "mbps" || p.MaxBandwidth != 20 {
+		t.Errorf("SetMaximum(20 Mbps) = %s %d, want mbps 20", p.BandwidthUnit, p.MaxBandwidth)
+	}
+}
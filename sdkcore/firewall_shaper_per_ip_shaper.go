@@ -0,0 +1,377 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallShaperPerIPShaper contains the parameters for Create and Update API function
+// MaxBandwidth is in BandwidthUnit, "kbps" by default, use Maximum and SetMaximum
+// to handle it as a Bandwidth value. The zero limits are unlimited.
+type JSONFirewallShaperPerIPShaper struct {
+	Name                    string `json:"name"`
+	MaxBandwidth            int    `json:"max-bandwidth"`
+	BandwidthUnit           string `json:"bandwidth-unit"`
+	MaxConcurrentSession    int    `json:"max-concurrent-session"`
+	MaxConcurrentTCPSession int    `json:"max-concurrent-tcp-session,omitempty"`
+	MaxConcurrentUDPSession int    `json:"max-concurrent-udp-session,omitempty"`
+}
+
+// JSONCreateFirewallShaperPerIPShaperOutput contains the output results for Create API function
+type JSONCreateFirewallShaperPerIPShaperOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallShaperPerIPShaperOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateFirewallShaperPerIPShaperOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallShaperPerIPShaper API operation for FortiOS creates a new per-IP traffic shaper, which limits the bandwidth and the sessions of each source address.
+// Returns the index value of the per-IP traffic shaper and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - per-ip-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallShaperPerIPShaper(params *JSONFirewallShaperPerIPShaper) (output *JSONCreateFirewallShaperPerIPShaperOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall.shaper/per-ip-shaper"
+	output = &JSONCreateFirewallShaperPerIPShaperOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallShaperPerIPShaper API operation for FortiOS updates the specified per-IP traffic shaper, which limits the bandwidth and the sessions of each source address.
+// Returns the index value of the per-IP traffic shaper and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - per-ip-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallShaperPerIPShaper(params *JSONFirewallShaperPerIPShaper, mkey string) (output *JSONUpdateFirewallShaperPerIPShaperOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall.shaper/per-ip-shaper"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateFirewallShaperPerIPShaperOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallShaperPerIPShaper API operation for FortiOS deletes the specified per-IP traffic shaper, which limits the bandwidth and the sessions of each source address.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - per-ip-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallShaperPerIPShaper(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall.shaper/per-ip-shaper"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallShaperPerIPShaper API operation for FortiOS gets the per-IP traffic shaper, which limits the bandwidth and the sessions of each source address
+// with the specified index value.
+// Returns the requested per-IP traffic shaper value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - per-ip-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallShaperPerIPShaper(mkey string) (output *JSONFirewallShaperPerIPShaper, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall.shaper/per-ip-shaper"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallShaperPerIPShaper{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallShaperPerIPShaper(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallShaperPerIPShapers API operation for FortiOS gets all the per-IP traffic shapers, which limit the bandwidth and the sessions of each source address.
+// Returns the per-IP traffic shapers when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - per-ip-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallShaperPerIPShapers() (output []*JSONFirewallShaperPerIPShaper, err error) {
+	results, err := c.listCmdbTable("firewall.shaper/per-ip-shaper")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallShaperPerIPShaper, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallShaperPerIPShaper{}
+		fillFirewallShaperPerIPShaper(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallShaperPerIPShaper fills output from a per-IP traffic shaper of the response
+func fillFirewallShaperPerIPShaper(output *JSONFirewallShaperPerIPShaper, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["max-bandwidth"] != nil {
+		output.MaxBandwidth = int(mapTmp["max-bandwidth"].(float64))
+	}
+	if mapTmp["bandwidth-unit"] != nil {
+		output.BandwidthUnit = mapTmp["bandwidth-unit"].(string)
+	}
+	if mapTmp["max-concurrent-session"] != nil {
+		output.MaxConcurrentSession = int(mapTmp["max-concurrent-session"].(float64))
+	}
+	if mapTmp["max-concurrent-tcp-session"] != nil {
+		output.MaxConcurrentTCPSession = int(mapTmp["max-concurrent-tcp-session"].(float64))
+	}
+	if mapTmp["max-concurrent-udp-session"] != nil {
+		output.MaxConcurrentUDPSession = int(mapTmp["max-concurrent-udp-session"].(float64))
+	}
+}
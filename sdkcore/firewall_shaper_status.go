@@ -0,0 +1,81 @@
+package forticlient
+
+import (
+	"fmt"
+)
+
+// JSONFirewallShaperStatus contains the current usage of a shared traffic shaper
+type JSONFirewallShaperStatus struct {
+	Name           string
+	Guaranteed     Bandwidth
+	Maximum        Bandwidth
+	Current        Bandwidth
+	Priority       string
+	DroppedPackets int64
+	DroppedBytes   int64
+}
+
+// ReadFirewallShaperStatus API operation for FortiOS gets the current bandwidth and the dropped
+// traffic of all the shared traffic shapers.
+// Returns the usage of each shaper when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the diagnose firewall shaper traffic-shaper list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallShaperStatus() (output []*JSONFirewallShaperStatus, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/monitor/firewall/shaper"
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	results, ok := result["results"].([]interface{})
+	if !ok {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	output = make([]*JSONFirewallShaperStatus, 0, len(results))
+	for _, v := range results {
+		mapTmp, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		unit := Kbps
+		if mapTmp["bandwidth_unit"] != nil {
+			unit, err = bandwidthUnit(mapTmp["bandwidth_unit"].(string))
+			if err != nil {
+				return
+			}
+		}
+
+		s := &JSONFirewallShaperStatus{}
+		if mapTmp["name"] != nil {
+			s.Name = mapTmp["name"].(string)
+		}
+		if mapTmp["guaranteed_bandwidth"] != nil {
+			s.Guaranteed = Bandwidth(mapTmp["guaranteed_bandwidth"].(float64)) * unit
+		}
+		if mapTmp["maximum_bandwidth"] != nil {
+			s.Maximum = Bandwidth(mapTmp["maximum_bandwidth"].(float64)) * unit
+		}
+		if mapTmp["current_bandwidth"] != nil {
+			s.Current = Bandwidth(mapTmp["current_bandwidth"].(float64)) * unit
+		}
+		if mapTmp["priority"] != nil {
+			s.Priority = fmt.Sprint(mapTmp["priority"])
+		}
+		if mapTmp["dropped_packets"] != nil {
+			s.DroppedPackets = int64(mapTmp["dropped_packets"].(float64))
+		}
+		if mapTmp["dropped_bytes"] != nil {
+			s.DroppedBytes = int64(mapTmp["dropped_bytes"].(float64))
+		}
+
+		output = append(output, s)
+	}
+
+	return
+}
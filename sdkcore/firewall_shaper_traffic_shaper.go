@@ -0,0 +1,385 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallShaperTrafficShaper contains the parameters for Create and Update API function
+// The bandwidths are in BandwidthUnit, "kbps" by default, use Guaranteed, Maximum
+// and SetBandwidth to handle them as Bandwidth values. Priority is "low", "medium" or "high".
+type JSONFirewallShaperTrafficShaper struct {
+	Name                string `json:"name"`
+	GuaranteedBandwidth int    `json:"guaranteed-bandwidth"`
+	MaximumBandwidth    int    `json:"maximum-bandwidth"`
+	BandwidthUnit       string `json:"bandwidth-unit"`
+	Priority            string `json:"priority"`
+	PerPolicy           string `json:"per-policy"`
+	Diffserv            string `json:"diffserv,omitempty"`
+	Diffservcode        string `json:"diffservcode,omitempty"`
+}
+
+// JSONCreateFirewallShaperTrafficShaperOutput contains the output results for Create API function
+type JSONCreateFirewallShaperTrafficShaperOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallShaperTrafficShaperOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateFirewallShaperTrafficShaperOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallShaperTrafficShaper API operation for FortiOS creates a new shared traffic shaper, which limits the bandwidth of the traffic of shaping policies.
+// Returns the index value of the shared traffic shaper and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - traffic-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallShaperTrafficShaper(params *JSONFirewallShaperTrafficShaper) (output *JSONCreateFirewallShaperTrafficShaperOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall.shaper/traffic-shaper"
+	output = &JSONCreateFirewallShaperTrafficShaperOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallShaperTrafficShaper API operation for FortiOS updates the specified shared traffic shaper, which limits the bandwidth of the traffic of shaping policies.
+// Returns the index value of the shared traffic shaper and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - traffic-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallShaperTrafficShaper(params *JSONFirewallShaperTrafficShaper, mkey string) (output *JSONUpdateFirewallShaperTrafficShaperOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall.shaper/traffic-shaper"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateFirewallShaperTrafficShaperOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallShaperTrafficShaper API operation for FortiOS deletes the specified shared traffic shaper, which limits the bandwidth of the traffic of shaping policies.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - traffic-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallShaperTrafficShaper(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall.shaper/traffic-shaper"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallShaperTrafficShaper API operation for FortiOS gets the shared traffic shaper, which limits the bandwidth of the traffic of shaping policies
+// with the specified index value.
+// Returns the requested shared traffic shaper value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - traffic-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallShaperTrafficShaper(mkey string) (output *JSONFirewallShaperTrafficShaper, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall.shaper/traffic-shaper"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallShaperTrafficShaper{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallShaperTrafficShaper(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallShaperTrafficShapers API operation for FortiOS gets all the shared traffic shapers, which limit the bandwidth of the traffic of shaping policies.
+// Returns the shared traffic shapers when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall shaper - traffic-shaper chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallShaperTrafficShapers() (output []*JSONFirewallShaperTrafficShaper, err error) {
+	results, err := c.listCmdbTable("firewall.shaper/traffic-shaper")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallShaperTrafficShaper, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallShaperTrafficShaper{}
+		fillFirewallShaperTrafficShaper(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallShaperTrafficShaper fills output from a shared traffic shaper of the response
+func fillFirewallShaperTrafficShaper(output *JSONFirewallShaperTrafficShaper, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["guaranteed-bandwidth"] != nil {
+		output.GuaranteedBandwidth = int(mapTmp["guaranteed-bandwidth"].(float64))
+	}
+	if mapTmp["maximum-bandwidth"] != nil {
+		output.MaximumBandwidth = int(mapTmp["maximum-bandwidth"].(float64))
+	}
+	if mapTmp["bandwidth-unit"] != nil {
+		output.BandwidthUnit = mapTmp["bandwidth-unit"].(string)
+	}
+	if mapTmp["priority"] != nil {
+		output.Priority = mapTmp["priority"].(string)
+	}
+	if mapTmp["per-policy"] != nil {
+		output.PerPolicy = mapTmp["per-policy"].(string)
+	}
+	if mapTmp["diffserv"] != nil {
+		output.Diffserv = mapTmp["diffserv"].(string)
+	}
+	if mapTmp["diffservcode"] != nil {
+		output.Diffservcode = mapTmp["diffservcode"].(string)
+	}
+}
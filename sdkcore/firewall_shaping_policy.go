@@ -0,0 +1,484 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallShapingPolicy contains the parameters for Create and Update API function
+type JSONFirewallShapingPolicy struct {
+	ID                   int        `json:"id,omitempty"`
+	Name                 string     `json:"name"`
+	Status               string     `json:"status"`
+	IPVersion            string     `json:"ip-version,omitempty"`
+	Srcintf              MultValues `json:"srcintf,omitempty"`
+	Dstintf              MultValues `json:"dstintf"`
+	Srcaddr              MultValues `json:"srcaddr"`
+	Dstaddr              MultValues `json:"dstaddr"`
+	Service              MultValues `json:"service"`
+	Schedule             string     `json:"schedule,omitempty"`
+	Users                MultValues `json:"users,omitempty"`
+	Groups               MultValues `json:"groups,omitempty"`
+	TrafficShaper        string     `json:"traffic-shaper"`
+	TrafficShaperReverse string     `json:"traffic-shaper-reverse"`
+	PerIPShaper          string     `json:"per-ip-shaper"`
+	ClassID              int        `json:"class-id,omitempty"`
+	Comment              string     `json:"comment"`
+}
+
+// JSONCreateFirewallShapingPolicyOutput contains the output results for Create API function
+type JSONCreateFirewallShapingPolicyOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallShapingPolicyOutput contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateFirewallShapingPolicyOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallShapingPolicy API operation for FortiOS creates a new shaping policy, which applies traffic shapers to the matching traffic.
+// Returns the index value of the shaping policy and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallShapingPolicy(params *JSONFirewallShapingPolicy) (output *JSONCreateFirewallShapingPolicyOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/shaping-policy"
+	output = &JSONCreateFirewallShapingPolicyOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallShapingPolicy API operation for FortiOS updates the specified shaping policy, which applies traffic shapers to the matching traffic.
+// Returns the index value of the shaping policy and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallShapingPolicy(params *JSONFirewallShapingPolicy, mkey string) (output *JSONUpdateFirewallShapingPolicyOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/shaping-policy"
+	path += "/" + mkey
+	output = &JSONUpdateFirewallShapingPolicyOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallShapingPolicy API operation for FortiOS deletes the specified shaping policy, which applies traffic shapers to the matching traffic.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallShapingPolicy(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/shaping-policy"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallShapingPolicy API operation for FortiOS gets the shaping policy, which applies traffic shapers to the matching traffic
+// with the specified index value.
+// Returns the requested shaping policy value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallShapingPolicy(mkey string) (output *JSONFirewallShapingPolicy, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/shaping-policy"
+	path += "/" + mkey
+
+	output = &JSONFirewallShapingPolicy{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallShapingPolicy(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallShapingPolicies API operation for FortiOS gets all the shaping policies, which apply traffic shapers to the matching traffic.
+// Returns the shaping policies when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallShapingPolicies() (output []*JSONFirewallShapingPolicy, err error) {
+	results, err := c.listCmdbTable("firewall/shaping-policy")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallShapingPolicy, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallShapingPolicy{}
+		fillFirewallShapingPolicy(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallShapingPolicy fills output from a shaping policy of the response
+func fillFirewallShapingPolicy(output *JSONFirewallShapingPolicy, mapTmp map[string]interface{}) {
+	if mapTmp["id"] != nil {
+		output.ID = int(mapTmp["id"].(float64))
+	}
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+	if mapTmp["ip-version"] != nil {
+		output.IPVersion = mapTmp["ip-version"].(string)
+	}
+	if mapTmp["srcintf"] != nil {
+		member := mapTmp["srcintf"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Srcintf = members
+	}
+	if mapTmp["dstintf"] != nil {
+		member := mapTmp["dstintf"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Dstintf = members
+	}
+	if mapTmp["srcaddr"] != nil {
+		member := mapTmp["srcaddr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Srcaddr = members
+	}
+	if mapTmp["dstaddr"] != nil {
+		member := mapTmp["dstaddr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Dstaddr = members
+	}
+	if mapTmp["service"] != nil {
+		member := mapTmp["service"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Service = members
+	}
+	if mapTmp["schedule"] != nil {
+		output.Schedule = mapTmp["schedule"].(string)
+	}
+	if mapTmp["users"] != nil {
+		member := mapTmp["users"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Users = members
+	}
+	if mapTmp["groups"] != nil {
+		member := mapTmp["groups"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Groups = members
+	}
+	if mapTmp["traffic-shaper"] != nil {
+		output.TrafficShaper = mapTmp["traffic-shaper"].(string)
+	}
+	if mapTmp["traffic-shaper-reverse"] != nil {
+		output.TrafficShaperReverse = mapTmp["traffic-shaper-reverse"].(string)
+	}
+	if mapTmp["per-ip-shaper"] != nil {
+		output.PerIPShaper = mapTmp["per-ip-shaper"].(string)
+	}
+	if mapTmp["class-id"] != nil {
+		output.ClassID = int(mapTmp["class-id"].(float64))
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+}
@@ -0,0 +1,393 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallShapingProfile contains the parameters for Create and Update API function
+type JSONFirewallShapingProfile struct {
+	ProfileName    string                        `json:"profile-name"`
+	Comment        string                        `json:"comment"`
+	Type           string                        `json:"type,omitempty"`
+	DefaultClassID int                           `json:"default-class-id"`
+	ShapingEntries []FirewallShapingProfileEntry `json:"shaping-entries"`
+}
+
+// FirewallShapingProfileEntry contains the share of a traffic class of a shaping profile
+// The bandwidths are percentages of the outbandwidth of the interface, Priority is
+// "top", "critical", "high", "medium" or "low".
+type FirewallShapingProfileEntry struct {
+	ID                            int    `json:"id,omitempty"`
+	ClassID                       int    `json:"class-id"`
+	Priority                      string `json:"priority"`
+	GuaranteedBandwidthPercentage int    `json:"guaranteed-bandwidth-percentage"`
+	MaximumBandwidthPercentage    int    `json:"maximum-bandwidth-percentage"`
+}
+
+// JSONCreateFirewallShapingProfileOutput contains the output results for Create API function
+type JSONCreateFirewallShapingProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallShapingProfileOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateFirewallShapingProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallShapingProfile API operation for FortiOS creates a new shaping profile, which shares the bandwidth of an interface between traffic classes.
+// Returns the index value of the shaping profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallShapingProfile(params *JSONFirewallShapingProfile) (output *JSONCreateFirewallShapingProfileOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/shaping-profile"
+	output = &JSONCreateFirewallShapingProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallShapingProfile API operation for FortiOS updates the specified shaping profile, which shares the bandwidth of an interface between traffic classes.
+// Returns the index value of the shaping profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallShapingProfile(params *JSONFirewallShapingProfile, mkey string) (output *JSONUpdateFirewallShapingProfileOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/shaping-profile"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateFirewallShapingProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallShapingProfile API operation for FortiOS deletes the specified shaping profile, which shares the bandwidth of an interface between traffic classes.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallShapingProfile(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/shaping-profile"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallShapingProfile API operation for FortiOS gets the shaping profile, which shares the bandwidth of an interface between traffic classes
+// with the specified index value.
+// Returns the requested shaping profile value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallShapingProfile(mkey string) (output *JSONFirewallShapingProfile, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/shaping-profile"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallShapingProfile{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallShapingProfile(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallShapingProfiles API operation for FortiOS gets all the shaping profiles, which share the bandwidth of an interface between traffic classes.
+// Returns the shaping profiles when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - shaping-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallShapingProfiles() (output []*JSONFirewallShapingProfile, err error) {
+	results, err := c.listCmdbTable("firewall/shaping-profile")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallShapingProfile, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallShapingProfile{}
+		fillFirewallShapingProfile(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallShapingProfile fills output from a shaping profile of the response
+func fillFirewallShapingProfile(output *JSONFirewallShapingProfile, mapTmp map[string]interface{}) {
+	if mapTmp["profile-name"] != nil {
+		output.ProfileName = mapTmp["profile-name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["default-class-id"] != nil {
+		output.DefaultClassID = int(mapTmp["default-class-id"].(float64))
+	}
+	if mapTmp["shaping-entries"] != nil {
+		member := mapTmp["shaping-entries"].([]interface{})
+
+		var members []FirewallShapingProfileEntry
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := FirewallShapingProfileEntry{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["class-id"] != nil {
+				m.ClassID = int(c["class-id"].(float64))
+			}
+			if c["priority"] != nil {
+				m.Priority = c["priority"].(string)
+			}
+			if c["guaranteed-bandwidth-percentage"] != nil {
+				m.GuaranteedBandwidthPercentage = int(c["guaranteed-bandwidth-percentage"].(float64))
+			}
+			if c["maximum-bandwidth-percentage"] != nil {
+				m.MaximumBandwidthPercentage = int(c["maximum-bandwidth-percentage"].(float64))
+			}
+			members = append(members, m)
+		}
+		output.ShapingEntries = members
+	}
+}
@@ -0,0 +1,767 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONFirewallSslSSHProfile contains the parameters for Create and Update API function
+type JSONFirewallSslSSHProfile struct {
+	Name              string                         `json:"name"`
+	Comment           string                         `json:"comment"`
+	Caname            string                         `json:"caname"`
+	UntrustedCaname   string                         `json:"untrusted-caname"`
+	ServerCertMode    string                         `json:"server-cert-mode"`
+	SslExemptionLog   string                         `json:"ssl-exemption-log,omitempty"`
+	SslAnomaliesLog   string                         `json:"ssl-anomalies-log,omitempty"`
+	SslNegotiationLog string                         `json:"ssl-negotiation-log,omitempty"`
+	SslExempt         []FirewallSslSSHProfileExempt  `json:"ssl-exempt"`
+	Ssl               *FirewallSslSSHProfileProtocol `json:"ssl,omitempty"`
+	HTTPS             *FirewallSslSSHProfileProtocol `json:"https,omitempty"`
+	Ftps              *FirewallSslSSHProfileProtocol `json:"ftps,omitempty"`
+	Imaps             *FirewallSslSSHProfileProtocol `json:"imaps,omitempty"`
+	Pop3s             *FirewallSslSSHProfileProtocol `json:"pop3s,omitempty"`
+	Smtps             *FirewallSslSSHProfileProtocol `json:"smtps,omitempty"`
+	SSH               *FirewallSslSSHProfileProtocol `json:"ssh,omitempty"`
+	Dot               *FirewallSslSSHProfileProtocol `json:"dot,omitempty"`
+}
+
+// FirewallSslSSHProfileProtocol contains the inspection parameters of a protocol of an SSL/SSH inspection profile
+type FirewallSslSSHProfileProtocol struct {
+	Ports                  string `json:"ports,omitempty"`
+	Status                 string `json:"status,omitempty"`
+	InspectAll             string `json:"inspect-all,omitempty"`
+	ProxyAfterTCPHandshake string `json:"proxy-after-tcp-handshake,omitempty"`
+	ClientCertificate      string `json:"client-certificate,omitempty"`
+	UnsupportedSsl         string `json:"unsupported-ssl,omitempty"`
+	ExpiredServerCert      string `json:"expired-server-cert,omitempty"`
+	RevokedServerCert      string `json:"revoked-server-cert,omitempty"`
+	UntrustedServerCert    string `json:"untrusted-server-cert,omitempty"`
+	CertValidationTimeout  string `json:"cert-validation-timeout,omitempty"`
+	CertValidationFailure  string `json:"cert-validation-failure,omitempty"`
+	SniServerCertCheck     string `json:"sni-server-cert-check,omitempty"`
+}
+
+// FirewallSslSSHProfileExempt contains a destination exempted from the SSL inspection
+type FirewallSslSSHProfileExempt struct {
+	ID                 int    `json:"id,omitempty"`
+	Type               string `json:"type"`
+	FortiguardCategory int    `json:"fortiguard-category,omitempty"`
+	Address            string `json:"address,omitempty"`
+	Address6           string `json:"address6,omitempty"`
+	WildcardFqdn       string `json:"wildcard-fqdn,omitempty"`
+	Regex              string `json:"regex,omitempty"`
+}
+
+// JSONCreateFirewallSslSSHProfileOutput contains the output results for Create API function
+type JSONCreateFirewallSslSSHProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateFirewallSslSSHProfileOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateFirewallSslSSHProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateFirewallSslSSHProfile API operation for FortiOS creates a new SSL/SSH inspection profile for firewall policies.
+// Returns the index value of the SSL/SSH inspection profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - ssl-ssh-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateFirewallSslSSHProfile(params *JSONFirewallSslSSHProfile) (output *JSONCreateFirewallSslSSHProfileOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/firewall/ssl-ssh-profile"
+	output = &JSONCreateFirewallSslSSHProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateFirewallSslSSHProfile API operation for FortiOS updates the specified SSL/SSH inspection profile for firewall policies.
+// Returns the index value of the SSL/SSH inspection profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - ssl-ssh-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateFirewallSslSSHProfile(params *JSONFirewallSslSSHProfile, mkey string) (output *JSONUpdateFirewallSslSSHProfileOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/firewall/ssl-ssh-profile"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateFirewallSslSSHProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteFirewallSslSSHProfile API operation for FortiOS deletes the specified SSL/SSH inspection profile for firewall policies.
+// Returns error for service API and SDK errors.
+// See the firewall - ssl-ssh-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteFirewallSslSSHProfile(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/firewall/ssl-ssh-profile"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadFirewallSslSSHProfile API operation for FortiOS gets the SSL/SSH inspection profile for firewall policies
+// with the specified index value.
+// Returns the requested SSL/SSH inspection profile value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - ssl-ssh-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadFirewallSslSSHProfile(mkey string) (output *JSONFirewallSslSSHProfile, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/firewall/ssl-ssh-profile"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONFirewallSslSSHProfile{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillFirewallSslSSHProfile(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListFirewallSslSSHProfiles API operation for FortiOS gets all the SSL/SSH inspection profiles for firewall policies.
+// Returns the SSL/SSH inspection profiles when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the firewall - ssl-ssh-profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListFirewallSslSSHProfiles() (output []*JSONFirewallSslSSHProfile, err error) {
+	results, err := c.listCmdbTable("firewall/ssl-ssh-profile")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONFirewallSslSSHProfile, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONFirewallSslSSHProfile{}
+		fillFirewallSslSSHProfile(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillFirewallSslSSHProfile fills output from a SSL/SSH inspection profile of the response
+func fillFirewallSslSSHProfile(output *JSONFirewallSslSSHProfile, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["caname"] != nil {
+		output.Caname = mapTmp["caname"].(string)
+	}
+	if mapTmp["untrusted-caname"] != nil {
+		output.UntrustedCaname = mapTmp["untrusted-caname"].(string)
+	}
+	if mapTmp["server-cert-mode"] != nil {
+		output.ServerCertMode = mapTmp["server-cert-mode"].(string)
+	}
+	if mapTmp["ssl-exemption-log"] != nil {
+		output.SslExemptionLog = mapTmp["ssl-exemption-log"].(string)
+	}
+	if mapTmp["ssl-anomalies-log"] != nil {
+		output.SslAnomaliesLog = mapTmp["ssl-anomalies-log"].(string)
+	}
+	if mapTmp["ssl-negotiation-log"] != nil {
+		output.SslNegotiationLog = mapTmp["ssl-negotiation-log"].(string)
+	}
+	if mapTmp["ssl-exempt"] != nil {
+		member := mapTmp["ssl-exempt"].([]interface{})
+
+		var members []FirewallSslSSHProfileExempt
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := FirewallSslSSHProfileExempt{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["type"] != nil {
+				m.Type = c["type"].(string)
+			}
+			if c["fortiguard-category"] != nil {
+				m.FortiguardCategory = int(c["fortiguard-category"].(float64))
+			}
+			if c["address"] != nil {
+				m.Address = c["address"].(string)
+			}
+			if c["address6"] != nil {
+				m.Address6 = c["address6"].(string)
+			}
+			if c["wildcard-fqdn"] != nil {
+				m.WildcardFqdn = c["wildcard-fqdn"].(string)
+			}
+			if c["regex"] != nil {
+				m.Regex = c["regex"].(string)
+			}
+			members = append(members, m)
+		}
+		output.SslExempt = members
+	}
+	if mapTmp["ssl"] != nil {
+		c := mapTmp["ssl"].(map[string]interface{})
+		m := &FirewallSslSSHProfileProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		if c["client-certificate"] != nil {
+			m.ClientCertificate = c["client-certificate"].(string)
+		}
+		if c["unsupported-ssl"] != nil {
+			m.UnsupportedSsl = c["unsupported-ssl"].(string)
+		}
+		if c["expired-server-cert"] != nil {
+			m.ExpiredServerCert = c["expired-server-cert"].(string)
+		}
+		if c["revoked-server-cert"] != nil {
+			m.RevokedServerCert = c["revoked-server-cert"].(string)
+		}
+		if c["untrusted-server-cert"] != nil {
+			m.UntrustedServerCert = c["untrusted-server-cert"].(string)
+		}
+		if c["cert-validation-timeout"] != nil {
+			m.CertValidationTimeout = c["cert-validation-timeout"].(string)
+		}
+		if c["cert-validation-failure"] != nil {
+			m.CertValidationFailure = c["cert-validation-failure"].(string)
+		}
+		if c["sni-server-cert-check"] != nil {
+			m.SniServerCertCheck = c["sni-server-cert-check"].(string)
+		}
+		output.Ssl = m
+	}
+	if mapTmp["https"] != nil {
+		c := mapTmp["https"].(map[string]interface{})
+		m := &FirewallSslSSHProfileProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		if c["client-certificate"] != nil {
+			m.ClientCertificate = c["client-certificate"].(string)
+		}
+		if c["unsupported-ssl"] != nil {
+			m.UnsupportedSsl = c["unsupported-ssl"].(string)
+		}
+		if c["expired-server-cert"] != nil {
+			m.ExpiredServerCert = c["expired-server-cert"].(string)
+		}
+		if c["revoked-server-cert"] != nil {
+			m.RevokedServerCert = c["revoked-server-cert"].(string)
+		}
+		if c["untrusted-server-cert"] != nil {
+			m.UntrustedServerCert = c["untrusted-server-cert"].(string)
+		}
+		if c["cert-validation-timeout"] != nil {
+			m.CertValidationTimeout = c["cert-validation-timeout"].(string)
+		}
+		if c["cert-validation-failure"] != nil {
+			m.CertValidationFailure = c["cert-validation-failure"].(string)
+		}
+		if c["sni-server-cert-check"] != nil {
+			m.SniServerCertCheck = c["sni-server-cert-check"].(string)
+		}
+		output.HTTPS = m
+	}
+	if mapTmp["ftps"] != nil {
+		c := mapTmp["ftps"].(map[string]interface{})
+		m := &FirewallSslSSHProfileProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		if c["client-certificate"] != nil {
+			m.ClientCertificate = c["client-certificate"].(string)
+		}
+		if c["unsupported-ssl"] != nil {
+			m.UnsupportedSsl = c["unsupported-ssl"].(string)
+		}
+		if c["expired-server-cert"] != nil {
+			m.ExpiredServerCert = c["expired-server-cert"].(string)
+		}
+		if c["revoked-server-cert"] != nil {
+			m.RevokedServerCert = c["revoked-server-cert"].(string)
+		}
+		if c["untrusted-server-cert"] != nil {
+			m.UntrustedServerCert = c["untrusted-server-cert"].(string)
+		}
+		if c["cert-validation-timeout"] != nil {
+			m.CertValidationTimeout = c["cert-validation-timeout"].(string)
+		}
+		if c["cert-validation-failure"] != nil {
+			m.CertValidationFailure = c["cert-validation-failure"].(string)
+		}
+		if c["sni-server-cert-check"] != nil {
+			m.SniServerCertCheck = c["sni-server-cert-check"].(string)
+		}
+		output.Ftps = m
+	}
+	if mapTmp["imaps"] != nil {
+		c := mapTmp["imaps"].(map[string]interface{})
+		m := &FirewallSslSSHProfileProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		if c["client-certificate"] != nil {
+			m.ClientCertificate = c["client-certificate"].(string)
+		}
+		if c["unsupported-ssl"] != nil {
+			m.UnsupportedSsl = c["unsupported-ssl"].(string)
+		}
+		if c["expired-server-cert"] != nil {
+			m.ExpiredServerCert = c["expired-server-cert"].(string)
+		}
+		if c["revoked-server-cert"] != nil {
+			m.RevokedServerCert = c["revoked-server-cert"].(string)
+		}
+		if c["untrusted-server-cert"] != nil {
+			m.UntrustedServerCert = c["untrusted-server-cert"].(string)
+		}
+		if c["cert-validation-timeout"] != nil {
+			m.CertValidationTimeout = c["cert-validation-timeout"].(string)
+		}
+		if c["cert-validation-failure"] != nil {
+			m.CertValidationFailure = c["cert-validation-failure"].(string)
+		}
+		if c["sni-server-cert-check"] != nil {
+			m.SniServerCertCheck = c["sni-server-cert-check"].(string)
+		}
+		output.Imaps = m
+	}
+	if mapTmp["pop3s"] != nil {
+		c := mapTmp["pop3s"].(map[string]interface{})
+		m := &FirewallSslSSHProfileProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		if c["client-certificate"] != nil {
+			m.ClientCertificate = c["client-certificate"].(string)
+		}
+		if c["unsupported-ssl"] != nil {
+			m.UnsupportedSsl = c["unsupported-ssl"].(string)
+		}
+		if c["expired-server-cert"] != nil {
+			m.ExpiredServerCert = c["expired-server-cert"].(string)
+		}
+		if c["revoked-server-cert"] != nil {
+			m.RevokedServerCert = c["revoked-server-cert"].(string)
+		}
+		if c["untrusted-server-cert"] != nil {
+			m.UntrustedServerCert = c["untrusted-server-cert"].(string)
+		}
+		if c["cert-validation-timeout"] != nil {
+			m.CertValidationTimeout = c["cert-validation-timeout"].(string)
+		}
+		if c["cert-validation-failure"] != nil {
+			m.CertValidationFailure = c["cert-validation-failure"].(string)
+		}
+		if c["sni-server-cert-check"] != nil {
+			m.SniServerCertCheck = c["sni-server-cert-check"].(string)
+		}
+		output.Pop3s = m
+	}
+	if mapTmp["smtps"] != nil {
+		c := mapTmp["smtps"].(map[string]interface{})
+		m := &FirewallSslSSHProfileProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		if c["client-certificate"] != nil {
+			m.ClientCertificate = c["client-certificate"].(string)
+		}
+		if c["unsupported-ssl"] != nil {
+			m.UnsupportedSsl = c["unsupported-ssl"].(string)
+		}
+		if c["expired-server-cert"] != nil {
+			m.ExpiredServerCert = c["expired-server-cert"].(string)
+		}
+		if c["revoked-server-cert"] != nil {
+			m.RevokedServerCert = c["revoked-server-cert"].(string)
+		}
+		if c["untrusted-server-cert"] != nil {
+			m.UntrustedServerCert = c["untrusted-server-cert"].(string)
+		}
+		if c["cert-validation-timeout"] != nil {
+			m.CertValidationTimeout = c["cert-validation-timeout"].(string)
+		}
+		if c["cert-validation-failure"] != nil {
+			m.CertValidationFailure = c["cert-validation-failure"].(string)
+		}
+		if c["sni-server-cert-check"] != nil {
+			m.SniServerCertCheck = c["sni-server-cert-check"].(string)
+		}
+		output.Smtps = m
+	}
+	if mapTmp["ssh"] != nil {
+		c := mapTmp["ssh"].(map[string]interface{})
+		m := &FirewallSslSSHProfileProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		if c["client-certificate"] != nil {
+			m.ClientCertificate = c["client-certificate"].(string)
+		}
+		if c["unsupported-ssl"] != nil {
+			m.UnsupportedSsl = c["unsupported-ssl"].(string)
+		}
+		if c["expired-server-cert"] != nil {
+			m.ExpiredServerCert = c["expired-server-cert"].(string)
+		}
+		if c["revoked-server-cert"] != nil {
+			m.RevokedServerCert = c["revoked-server-cert"].(string)
+		}
+		if c["untrusted-server-cert"] != nil {
+			m.UntrustedServerCert = c["untrusted-server-cert"].(string)
+		}
+		if c["cert-validation-timeout"] != nil {
+			m.CertValidationTimeout = c["cert-validation-timeout"].(string)
+		}
+		if c["cert-validation-failure"] != nil {
+			m.CertValidationFailure = c["cert-validation-failure"].(string)
+		}
+		if c["sni-server-cert-check"] != nil {
+			m.SniServerCertCheck = c["sni-server-cert-check"].(string)
+		}
+		output.SSH = m
+	}
+	if mapTmp["dot"] != nil {
+		c := mapTmp["dot"].(map[string]interface{})
+		m := &FirewallSslSSHProfileProtocol{}
+		if c["ports"] != nil {
+			m.Ports = fmt.Sprint(c["ports"])
+		}
+		if c["status"] != nil {
+			m.Status = c["status"].(string)
+		}
+		if c["inspect-all"] != nil {
+			m.InspectAll = c["inspect-all"].(string)
+		}
+		if c["proxy-after-tcp-handshake"] != nil {
+			m.ProxyAfterTCPHandshake = c["proxy-after-tcp-handshake"].(string)
+		}
+		if c["client-certificate"] != nil {
+			m.ClientCertificate = c["client-certificate"].(string)
+		}
+		if c["unsupported-ssl"] != nil {
+			m.UnsupportedSsl = c["unsupported-ssl"].(string)
+		}
+		if c["expired-server-cert"] != nil {
+			m.ExpiredServerCert = c["expired-server-cert"].(string)
+		}
+		if c["revoked-server-cert"] != nil {
+			m.RevokedServerCert = c["revoked-server-cert"].(string)
+		}
+		if c["untrusted-server-cert"] != nil {
+			m.UntrustedServerCert = c["untrusted-server-cert"].(string)
+		}
+		if c["cert-validation-timeout"] != nil {
+			m.CertValidationTimeout = c["cert-validation-timeout"].(string)
+		}
+		if c["cert-validation-failure"] != nil {
+			m.CertValidationFailure = c["cert-validation-failure"].(string)
+		}
+		if c["sni-server-cert-check"] != nil {
+			m.SniServerCertCheck = c["sni-server-cert-check"].(string)
+		}
+		output.Dot = m
+	}
+}
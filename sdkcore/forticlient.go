@@ -28,6 +28,10 @@ type MultValues []MultValue
 type FortiSDKClient struct {
 	Config  config.Config
 	Retries int
+
+	// isdb caches the Internet Service Database names, it is shared by the copies
+	// of the client such as the transaction clients, see InternetServices
+	isdb *InternetServiceResolver
 }
 
 // ExtractString extracts strings from result and put them into a string array,
@@ -54,6 +58,7 @@ func NewClient(auth *auth.Auth, client *http.Client) *FortiSDKClient {
 	c.Config.Auth = auth
 	c.Config.HTTPCon = client
 	c.Config.FwTarget = auth.Hostname
+	c.isdb = &InternetServiceResolver{c: c}
 
 	return c
 }
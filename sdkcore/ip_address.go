@@ -0,0 +1,215 @@
+package forticlient
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// ParseSubnet parses an address with its prefix length in the "10.0.0.0/24" notation,
+// in the "10.0.0.0 255.255.255.0" notation returned by FortiOS, or a single address.
+// The host bits of the address are kept.
+func ParseSubnet(s string) (netip.Prefix, error) {
+	f := strings.Fields(s)
+
+	switch len(f) {
+	case 1:
+		if strings.Contains(f[0], "/") {
+			return netip.ParsePrefix(f[0])
+		}
+		addr, err := netip.ParseAddr(f[0])
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return addr.Prefix(addr.BitLen())
+	case 2:
+		addr, err := netip.ParseAddr(f[0])
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		bits, err := maskBits(f[1])
+		if err != nil || !addr.Is4() {
+			return netip.Prefix{}, fmt.Errorf("invalid netmask %q", f[1])
+		}
+		return netip.PrefixFrom(addr, bits), nil
+	}
+
+	return netip.Prefix{}, fmt.Errorf("invalid subnet %q", s)
+}
+
+// maskBits returns the prefix length of an IPv4 netmask such as "255.255.255.0"
+func maskBits(s string) (int, error) {
+	mask, err := netip.ParseAddr(s)
+	if err != nil || !mask.Is4() {
+		return 0, fmt.Errorf("invalid netmask %q", s)
+	}
+
+	m := mask.As4()
+	v := uint32(m[0])<<24 | uint32(m[1])<<16 | uint32(m[2])<<8 | uint32(m[3])
+	bits := 0
+	for v&0x80000000 != 0 {
+		bits++
+		v <<= 1
+	}
+	if v != 0 {
+		return 0, fmt.Errorf("invalid netmask %q", s)
+	}
+
+	return bits, nil
+}
+
+// FormatSubnet formats the prefix the way FortiOS returns it,
+// "10.0.0.0 255.255.255.0" for IPv4 and "2001:db8::/64" for IPv6
+func FormatSubnet(p netip.Prefix) string {
+	if !p.Addr().Is4() {
+		return p.String()
+	}
+
+	v := uint32(0)
+	if p.Bits() > 0 {
+		v = ^uint32(0) << uint(32-p.Bits())
+	}
+	mask := netip.AddrFrom4([4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)})
+
+	return p.Addr().String() + " " + mask.String()
+}
+
+// NormalizeSubnet converts a network in any of the notations accepted by ParseSubnet
+// to the FortiOS notation.
+// Returns error when the address has host bits set, such as "10.0.0.1/24".
+func NormalizeSubnet(s string) (string, error) {
+	p, err := ParseSubnet(s)
+	if err != nil {
+		return "", err
+	}
+	if p.Masked() != p {
+		return "", fmt.Errorf("subnet %q has host bits set, the network is %s", s, p.Masked())
+	}
+
+	return FormatSubnet(p), nil
+}
+
+// NormalizeInterfaceIP converts an interface address in any of the notations accepted by
+// ParseSubnet to the FortiOS notation. The host bits are the address of the interface.
+// Returns error when the address is the network or the broadcast address of an IPv4 subnet.
+func NormalizeInterfaceIP(s string) (string, error) {
+	p, err := ParseSubnet(s)
+	if err != nil {
+		return "", err
+	}
+
+	if p.Addr().Is4() && p.Bits() > 0 && p.Bits() < 31 && !p.Addr().IsUnspecified() {
+		r := prefixLast(p)
+		if p.Addr() == p.Masked().Addr() || p.Addr() == r {
+			return "", fmt.Errorf("%q is not a host address of the subnet %s", s, p.Masked())
+		}
+	}
+
+	return FormatSubnet(p), nil
+}
+
+// ParseIPRange parses the start and end addresses of a range.
+// Returns error when the addresses are not of the same family or start is after end.
+func ParseIPRange(start string, end string) (from netip.Addr, to netip.Addr, err error) {
+	from, err = netip.ParseAddr(strings.TrimSpace(start))
+	if err != nil {
+		return
+	}
+	to, err = netip.ParseAddr(strings.TrimSpace(end))
+	if err != nil {
+		return
+	}
+
+	if from.Is4() != to.Is4() {
+		err = fmt.Errorf("start-ip %s and end-ip %s are not of the same family", start, end)
+		return
+	}
+	if to.Less(from) {
+		err = fmt.Errorf("start-ip %s is after end-ip %s", start, end)
+		return
+	}
+
+	return
+}
+
+// prefixLast returns the last address of the prefix
+func prefixLast(p netip.Prefix) netip.Addr {
+	b := p.Masked().Addr().AsSlice()
+	for i := p.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> uint(i%8)
+	}
+	last, _ := netip.AddrFromSlice(b)
+	return last
+}
+
+// Prefix returns the subnet of the address
+func (s *JSONFirewallObjectAddressIPMask) Prefix() (netip.Prefix, error) {
+	return ParseSubnet(s.Subnet)
+}
+
+// SetPrefix sets the subnet of the address in the FortiOS notation
+func (s *JSONFirewallObjectAddressIPMask) SetPrefix(p netip.Prefix) {
+	s.Subnet = FormatSubnet(p)
+}
+
+// Range returns the start and end addresses of the range
+func (r *JSONFirewallObjectAddressIPRange) Range() (from netip.Addr, to netip.Addr, err error) {
+	return ParseIPRange(r.StartIP, r.EndIP)
+}
+
+// SetRange sets the start and end addresses of the range
+func (r *JSONFirewallObjectAddressIPRange) SetRange(from netip.Addr, to netip.Addr) {
+	r.StartIP = from.String()
+	r.EndIP = to.String()
+}
+
+// Prefix returns the address of the interface with the prefix length of its subnet
+func (p *JSONNetworkingInterfacePort) Prefix() (netip.Prefix, error) {
+	return ParseSubnet(p.Ipf)
+}
+
+// SetPrefix sets the address of the interface in the FortiOS notation
+func (p *JSONNetworkingInterfacePort) SetPrefix(v netip.Prefix) {
+	p.Ipf = FormatSubnet(v)
+}
+
+// normalize returns a copy of the address with the subnet in the FortiOS notation.
+// Returns error for an invalid subnet or range.
+func (a *JSONFirewallObjectAddress) normalize() (*JSONFirewallObjectAddress, error) {
+	n := *a
+
+	if a.JSONFirewallObjectAddressIPMask != nil && a.Subnet != "" {
+		subnet, err := NormalizeSubnet(a.Subnet)
+		if err != nil {
+			return nil, err
+		}
+		n.JSONFirewallObjectAddressIPMask = &JSONFirewallObjectAddressIPMask{Subnet: subnet}
+	}
+
+	if a.JSONFirewallObjectAddressIPRange != nil && a.StartIP != "" && a.EndIP != "" {
+		from, to, err := a.Range()
+		if err != nil {
+			return nil, err
+		}
+		n.JSONFirewallObjectAddressIPRange = &JSONFirewallObjectAddressIPRange{}
+		n.SetRange(from, to)
+	}
+
+	return &n, nil
+}
+
+// normalize returns a copy of the interface with the address in the FortiOS notation.
+// Returns error for an invalid address.
+func (p *JSONNetworkingInterfacePort) normalize() (*JSONNetworkingInterfacePort, error) {
+	n := *p
+
+	if p.Ipf != "" {
+		ip, err := NormalizeInterfaceIP(p.Ipf)
+		if err != nil {
+			return nil, err
+		}
+		n.Ipf = ip
+	}
+
+	return &n, nil
+}
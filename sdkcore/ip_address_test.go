@@ -0,0 +1,217 @@
+package forticlient
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestParseSubnet(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"10.0.0.0/24", "10.0.0.0/24"},
+		{"10.0.0.0 255.255.255.0", "10.0.0.0/24"},
+		{"  10.0.0.0   255.255.0.0 ", "10.0.0.0/16"},
+		{"10.0.0.1 255.255.255.0", "10.0.0.1/24"},
+		{"0.0.0.0 0.0.0.0", "0.0.0.0/0"},
+		{"192.0.2.7", "192.0.2.7/32"},
+		{"2001:db8::/64", "2001:db8::/64"},
+		{"2001:db8::1", "2001:db8::1/128"},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSubnet(tt.s)
+		if err != nil {
+			t.Errorf("ParseSubnet(%q) error = %v", tt.s, err)
+			continue
+		}
+		if got != netip.MustParsePrefix(tt.want) {
+			t.Errorf("ParseSubnet(%q) = %s, want %s", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestParseSubnetErrors(t *testing.T) {
+	for _, s := range []string{
+		"", "10.0.0.0/33", "10.0.0", "host.example.com",
+		"10.0.0.0 255.0.255.0", "10.0.0.0 255.255.255.1", "10.0.0.0 24", "10.0.0.0 ffff::",
+		"2001:db8:: 255.255.255.0", "10.0.0.0 255.255.255.0 extra",
+	} {
+		if got, err := ParseSubnet(s); err == nil {
+			t.Errorf("ParseSubnet(%q) = %s, want error", s, got)
+		}
+	}
+}
+
+func TestNormalizeSubnet(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"10.0.0.0/24", "10.0.0.0 255.255.255.0"},
+		{"10.0.0.0 255.255.255.0", "10.0.0.0 255.255.255.0"},
+		{"192.0.2.7", "192.0.2.7 255.255.255.255"},
+		{"0.0.0.0/0", "0.0.0.0 0.0.0.0"},
+		{"2001:db8::/64", "2001:db8::/64"},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizeSubnet(tt.s)
+		if err != nil {
+			t.Errorf("NormalizeSubnet(%q) error = %v", tt.s, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeSubnet(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+
+	for _, s := range []string{"10.0.0.1/24", "10.0.0.1 255.255.255.0", "2001:db8::1/64", "10.0.0.0 255.0.255.0"} {
+		if got, err := NormalizeSubnet(s); err == nil {
+			t.Errorf("NormalizeSubnet(%q) = %q, want error", s, got)
+		}
+	}
+}
+
+func TestNormalizeInterfaceIP(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+		err  bool
+	}{
+		{s: "10.0.0.1/24", want: "10.0.0.1 255.255.255.0"},
+		{s: "10.0.0.254 255.255.255.0", want: "10.0.0.254 255.255.255.0"},
+		{s: "10.0.0.0/31", want: "10.0.0.0 255.255.255.254"},
+		{s: "0.0.0.0 0.0.0.0", want: "0.0.0.0 0.0.0.0"},
+		{s: "10.0.0.0/24", err: true},
+		{s: "10.0.0.255/24", err: true},
+		{s: "10.0.0.1 255.0.255.0", err: true},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizeInterfaceIP(tt.s)
+		if (err != nil) != tt.err {
+			t.Errorf("NormalizeInterfaceIP(%q) error = %v, want error %v", tt.s, err, tt.err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizeInterfaceIP(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestParseIPRange(t *testing.T) {
+	tests := []struct {
+		start, end string
+		err        bool
+	}{
+		{start: "10.0.0.1", end: "10.0.0.9"},
+		{start: " 10.0.0.1 ", end: "10.0.0.1"},
+		{start: "2001:db8::1", end: "2001:db8::ff"},
+		{start: "10.0.0.9", end: "10.0.0.1", err: true},
+		{start: "10.0.0.1", end: "2001:db8::1", err: true},
+		{start: "10.0.0", end: "10.0.0.1", err: true},
+		{start: "10.0.0.1", end: "", err: true},
+	}
+
+	for _, tt := range tests {
+		from, to, err := ParseIPRange(tt.start, tt.end)
+		if (err != nil) != tt.err {
+			t.Errorf("ParseIPRange(%q, %q) error = %v, want error %v", tt.start, tt.end, err, tt.err)
+			continue
+		}
+		if err == nil && (to.Less(from) || from.Is4() != to.Is4()) {
+			t.Errorf("ParseIPRange(%q, %q) = %s, %s", tt.start, tt.end, from, to)
+		}
+	}
+}
+
+func TestFirewallObjectAddressNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		address JSONFirewallObjectAddress
+		subnet  string
+		startIP string
+		endIP   string
+		err     bool
+	}{
+		{
+			name:    "CIDR subnet",
+			address: JSONFirewallObjectAddress{JSONFirewallObjectAddressIPMask: &JSONFirewallObjectAddressIPMask{Subnet: "10.1.0.0/16"}},
+			subnet:  "10.1.0.0 255.255.0.0",
+		},
+		{
+			name:    "host bits set",
+			address: JSONFirewallObjectAddress{JSONFirewallObjectAddressIPMask: &JSONFirewallObjectAddressIPMask{Subnet: "10.1.0.1/16"}},
+			err:     true,
+		},
+		{
+			name:    "bad mask",
+			address: JSONFirewallObjectAddress{JSONFirewallObjectAddressIPMask: &JSONFirewallObjectAddressIPMask{Subnet: "10.1.0.0 255.0.255.0"}},
+			err:     true,
+		},
+		{
+			name: "range",
+			address: JSONFirewallObjectAddress{
+				JSONFirewallObjectAddressIPRange: &JSONFirewallObjectAddressIPRange{StartIP: " 10.0.0.1", EndIP: "10.0.0.9 "},
+			},
+			startIP: "10.0.0.1",
+			endIP:   "10.0.0.9",
+		},
+		{
+			name: "reversed range",
+			address: JSONFirewallObjectAddress{
+				JSONFirewallObjectAddressIPRange: &JSONFirewallObjectAddressIPRange{StartIP: "10.0.0.9", EndIP: "10.0.0.1"},
+			},
+			err: true,
+		},
+		{
+			name:    "other type",
+			address: JSONFirewallObjectAddress{JSONFirewallObjectAddressFqdn: &JSONFirewallObjectAddressFqdn{Fqdn: "www.example.com"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := tt.address
+			got, err := tt.address.normalize()
+			if (err != nil) != tt.err {
+				t.Fatalf("normalize() error = %v, want error %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.subnet != "" && got.Subnet != tt.subnet {
+				t.Errorf("subnet = %q, want %q", got.Subnet, tt.subnet)
+			}
+			if tt.startIP != "" && (got.StartIP != tt.startIP || got.EndIP != tt.endIP) {
+				t.Errorf("range = %q-%q, want %q-%q", got.StartIP, got.EndIP, tt.startIP, tt.endIP)
+			}
+			if tt.address.JSONFirewallObjectAddressIPMask != orig.JSONFirewallObjectAddressIPMask ||
+				tt.address.JSONFirewallObjectAddressIPRange != orig.JSONFirewallObjectAddressIPRange {
+				t.Error("normalize() changed the original address")
+			}
+		})
+	}
+}
+
+func TestNetworkingInterfacePortNormalize(t *testing.T) {
+	p := &JSONNetworkingInterfacePort{Ipf: "192.168.1.99/24"}
+
+	got, err := p.normalize()
+	if err != nil {
+		t.Fatalf("normalize() error = %v", err)
+	}
+	if got.Ipf != "192.168.1.99 255.255.255.0" || p.Ipf != "192.168.1.99/24" {
+		t.Errorf("normalize() = %q, original %q, want the FortiOS notation in a copy", got.Ipf, p.Ipf)
+	}
+
+	if _, err := (&JSONNetworkingInterfacePort{Ipf: "192.168.1.0/24"}).normalize(); err == nil {
+		t.Error("normalize() error = nil, want error for the network address")
+	}
+	if got, err := (&JSONNetworkingInterfacePort{}).normalize(); err != nil || got.Ipf != "" {
+		t.Errorf("normalize() = %q, %v, want an empty address kept", got.Ipf, err)
+	}
+}
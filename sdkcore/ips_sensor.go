@@ -0,0 +1,467 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONIpsSensor contains the parameters for Create and Update API function
+type JSONIpsSensor struct {
+	Name                  string           `json:"name"`
+	Comment               string           `json:"comment"`
+	ReplacemsgGroup       string           `json:"replacemsg-group"`
+	BlockMaliciousURL     string           `json:"block-malicious-url"`
+	ScanBotnetConnections string           `json:"scan-botnet-connections"`
+	ExtendedLog           string           `json:"extended-log"`
+	Entries               []IpsSensorEntry `json:"entries"`
+}
+
+// IpsSensorEntry contains the signatures selected by an entry of an IPS sensor and their action
+type IpsSensorEntry struct {
+	ID               int                      `json:"id,omitempty"`
+	Rule             []IpsSensorRuleMultValue `json:"rule"`
+	Location         string                   `json:"location"`
+	Severity         string                   `json:"severity"`
+	Protocol         string                   `json:"protocol"`
+	OS               string                   `json:"os"`
+	Application      string                   `json:"application"`
+	Status           string                   `json:"status"`
+	Log              string                   `json:"log"`
+	LogPacket        string                   `json:"log-packet"`
+	LogAttackContext string                   `json:"log-attack-context"`
+	Action           string                   `json:"action"`
+	Quarantine       string                   `json:"quarantine"`
+	QuarantineExpiry string                   `json:"quarantine-expiry,omitempty"`
+	RateCount        int                      `json:"rate-count,omitempty"`
+	RateDuration     int                      `json:"rate-duration,omitempty"`
+	RateMode         string                   `json:"rate-mode"`
+	RateTrack        string                   `json:"rate-track"`
+}
+
+// IpsSensorRuleMultValue contains the output results for Read API function
+type IpsSensorRuleMultValue struct {
+	ID int `json:"id"`
+}
+
+// JSONCreateIpsSensorOutput contains the output results for Create API function
+type JSONCreateIpsSensorOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateIpsSensorOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateIpsSensorOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateIpsSensor API operation for FortiOS creates a new IPS sensor for firewall policies.
+// Returns the index value of the IPS sensor and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the ips - sensor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateIpsSensor(params *JSONIpsSensor) (output *JSONCreateIpsSensorOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/ips/sensor"
+	output = &JSONCreateIpsSensorOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateIpsSensor API operation for FortiOS updates the specified IPS sensor for firewall policies.
+// Returns the index value of the IPS sensor and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the ips - sensor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateIpsSensor(params *JSONIpsSensor, mkey string) (output *JSONUpdateIpsSensorOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/ips/sensor"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateIpsSensorOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteIpsSensor API operation for FortiOS deletes the specified IPS sensor for firewall policies.
+// Returns error for service API and SDK errors.
+// See the ips - sensor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteIpsSensor(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/ips/sensor"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadIpsSensor API operation for FortiOS gets the IPS sensor for firewall policies
+// with the specified index value.
+// Returns the requested IPS sensor value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the ips - sensor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadIpsSensor(mkey string) (output *JSONIpsSensor, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/ips/sensor"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONIpsSensor{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillIpsSensor(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListIpsSensors API operation for FortiOS gets all the IPS sensors for firewall policies.
+// Returns the IPS sensors when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the ips - sensor chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListIpsSensors() (output []*JSONIpsSensor, err error) {
+	results, err := c.listCmdbTable("ips/sensor")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONIpsSensor, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONIpsSensor{}
+		fillIpsSensor(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillIpsSensor fills output from a IPS sensor of the response
+func fillIpsSensor(output *JSONIpsSensor, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["replacemsg-group"] != nil {
+		output.ReplacemsgGroup = mapTmp["replacemsg-group"].(string)
+	}
+	if mapTmp["block-malicious-url"] != nil {
+		output.BlockMaliciousURL = mapTmp["block-malicious-url"].(string)
+	}
+	if mapTmp["scan-botnet-connections"] != nil {
+		output.ScanBotnetConnections = mapTmp["scan-botnet-connections"].(string)
+	}
+	if mapTmp["extended-log"] != nil {
+		output.ExtendedLog = mapTmp["extended-log"].(string)
+	}
+	if mapTmp["entries"] != nil {
+		member := mapTmp["entries"].([]interface{})
+
+		var members []IpsSensorEntry
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := IpsSensorEntry{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["rule"] != nil {
+				member := c["rule"].([]interface{})
+
+				var members []IpsSensorRuleMultValue
+				for _, v := range member {
+					c := v.(map[string]interface{})
+					m := IpsSensorRuleMultValue{}
+					if c["id"] != nil {
+						m.ID = int(c["id"].(float64))
+					}
+					members = append(members, m)
+				}
+				m.Rule = members
+			}
+			if c["location"] != nil {
+				m.Location = c["location"].(string)
+			}
+			if c["severity"] != nil {
+				m.Severity = c["severity"].(string)
+			}
+			if c["protocol"] != nil {
+				m.Protocol = c["protocol"].(string)
+			}
+			if c["os"] != nil {
+				m.OS = c["os"].(string)
+			}
+			if c["application"] != nil {
+				m.Application = c["application"].(string)
+			}
+			if c["status"] != nil {
+				m.Status = c["status"].(string)
+			}
+			if c["log"] != nil {
+				m.Log = c["log"].(string)
+			}
+			if c["log-packet"] != nil {
+				m.LogPacket = c["log-packet"].(string)
+			}
+			if c["log-attack-context"] != nil {
+				m.LogAttackContext = c["log-attack-context"].(string)
+			}
+			if c["action"] != nil {
+				m.Action = c["action"].(string)
+			}
+			if c["quarantine"] != nil {
+				m.Quarantine = c["quarantine"].(string)
+			}
+			if c["quarantine-expiry"] != nil {
+				m.QuarantineExpiry = c["quarantine-expiry"].(string)
+			}
+			if c["rate-count"] != nil {
+				m.RateCount = int(c["rate-count"].(float64))
+			}
+			if c["rate-duration"] != nil {
+				m.RateDuration = int(c["rate-duration"].(float64))
+			}
+			if c["rate-mode"] != nil {
+				m.RateMode = c["rate-mode"].(string)
+			}
+			if c["rate-track"] != nil {
+				m.RateTrack = c["rate-track"].(string)
+			}
+			members = append(members, m)
+		}
+		output.Entries = members
+	}
+}
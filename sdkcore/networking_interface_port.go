@@ -61,6 +61,12 @@ func (c *FortiSDKClient) CreateNetworkingInterfacePort(params *JSONNetworkingInt
 	HTTPMethod := "POST"
 	path := "/api/v2/cmdb/system/interface"
 	output = &JSONCreateNetworkingInterfacePortOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
@@ -137,6 +143,12 @@ func (c *FortiSDKClient) UpdateNetworkingInterfacePort(params *JSONNetworkingInt
 	path := "/api/v2/cmdb/system/interface"
 	path += "/" + EscapeURLString(mkey)
 	output = &JSONUpdateNetworkingInterfacePortOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
 	locJSON, err := json.Marshal(params)
 	if err != nil {
 		log.Fatal(err)
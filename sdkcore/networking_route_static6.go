@@ -0,0 +1,377 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strconv"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONNetworkingRouteStatic6 contains the parameters for Create and Update API function
+// SeqNum is the index value of the route, FortiOS assigns it on Create when it is 0.
+type JSONNetworkingRouteStatic6 struct {
+	SeqNum    int    `json:"seq-num,omitempty"`
+	Dst       string `json:"dst"`
+	Gateway   string `json:"gateway"`
+	Blackhole string `json:"blackhole"`
+	Distance  string `json:"distance"`
+	Priority  string `json:"priority"`
+	Device    string `json:"device"`
+	Comment   string `json:"comment"`
+	Status    string `json:"status"`
+}
+
+// JSONCreateNetworkingRouteStatic6Output contains the output results for Create API function
+type JSONCreateNetworkingRouteStatic6Output struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateNetworkingRouteStatic6Output contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateNetworkingRouteStatic6Output struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateNetworkingRouteStatic6 API operation for FortiOS creates a new IPv6 static route.
+// Returns the index value of the IPv6 static route and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - static6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateNetworkingRouteStatic6(params *JSONNetworkingRouteStatic6) (output *JSONCreateNetworkingRouteStatic6Output, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/static6"
+	output = &JSONCreateNetworkingRouteStatic6Output{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateNetworkingRouteStatic6 API operation for FortiOS updates the specified IPv6 static route.
+// Returns the index value of the IPv6 static route and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - static6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateNetworkingRouteStatic6(params *JSONNetworkingRouteStatic6, mkey string) (output *JSONUpdateNetworkingRouteStatic6Output, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/static6"
+	path += "/" + mkey
+	output = &JSONUpdateNetworkingRouteStatic6Output{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteNetworkingRouteStatic6 API operation for FortiOS deletes the specified IPv6 static route.
+// Returns error for service API and SDK errors.
+// See the router - static6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteNetworkingRouteStatic6(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/static6"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadNetworkingRouteStatic6 API operation for FortiOS gets the IPv6 static route
+// with the specified index value.
+// Returns the requested IPv6 static route value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - static6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadNetworkingRouteStatic6(mkey string) (output *JSONNetworkingRouteStatic6, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/static6"
+	path += "/" + mkey
+
+	output = &JSONNetworkingRouteStatic6{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillNetworkingRouteStatic6(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListNetworkingRouteStatic6s API operation for FortiOS gets all the IPv6 static routes.
+// Returns the IPv6 static routes when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - static6 chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListNetworkingRouteStatic6s() (output []*JSONNetworkingRouteStatic6, err error) {
+	results, err := c.listCmdbTable("router/static6")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONNetworkingRouteStatic6, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONNetworkingRouteStatic6{}
+		fillNetworkingRouteStatic6(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillNetworkingRouteStatic6 fills output from a IPv6 static route of the response
+func fillNetworkingRouteStatic6(output *JSONNetworkingRouteStatic6, mapTmp map[string]interface{}) {
+	if mapTmp["seq-num"] != nil {
+		output.SeqNum = int(mapTmp["seq-num"].(float64))
+	}
+	if mapTmp["dst"] != nil {
+		output.Dst = mapTmp["dst"].(string)
+	}
+	if mapTmp["gateway"] != nil {
+		output.Gateway = mapTmp["gateway"].(string)
+	}
+	if mapTmp["blackhole"] != nil {
+		output.Blackhole = mapTmp["blackhole"].(string)
+	}
+	if mapTmp["distance"] != nil {
+		output.Distance = strconv.Itoa(int(mapTmp["distance"].(float64)))
+	}
+	if mapTmp["priority"] != nil {
+		output.Priority = strconv.Itoa(int(mapTmp["priority"].(float64)))
+	}
+	if mapTmp["device"] != nil {
+		output.Device = mapTmp["device"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+}
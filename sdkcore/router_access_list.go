@@ -0,0 +1,425 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterAccessList contains the parameters for Create and Update API function
+// The rules are evaluated in order, the first rule matching a route applies.
+type JSONRouterAccessList struct {
+	Name     string                 `json:"name"`
+	Comments string                 `json:"comments"`
+	Rule     []RouterAccessListRule `json:"rule"`
+}
+
+// RouterAccessListRule contains a rule of an access list
+// Prefix accepts the "10.0.0.0/24" and the "10.0.0.0 255.255.255.0" notations, "any" matches every route.
+// ExactMatch "enable" only matches the routes with the same prefix length.
+type RouterAccessListRule struct {
+	ID         int    `json:"id,omitempty"`
+	Action     string `json:"action"`
+	Prefix     string `json:"prefix"`
+	Wildcard   string `json:"wildcard,omitempty"`
+	ExactMatch string `json:"exact-match,omitempty"`
+}
+
+// JSONCreateRouterAccessListOutput contains the output results for Create API function
+type JSONCreateRouterAccessListOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateRouterAccessListOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateRouterAccessListOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateRouterAccessList API operation for FortiOS creates a new access list for route filtering.
+// Returns the index value of the access list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - access-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateRouterAccessList(params *JSONRouterAccessList) (output *JSONCreateRouterAccessListOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/access-list"
+	output = &JSONCreateRouterAccessListOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateRouterAccessList API operation for FortiOS updates the specified access list for route filtering.
+// Returns the index value of the access list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - access-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterAccessList(params *JSONRouterAccessList, mkey string) (output *JSONUpdateRouterAccessListOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/access-list"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateRouterAccessListOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteRouterAccessList API operation for FortiOS deletes the specified access list for route filtering.
+// Returns error for service API and SDK errors.
+// See the router - access-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteRouterAccessList(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/access-list"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterAccessList API operation for FortiOS gets the access list for route filtering
+// with the specified index value.
+// Returns the requested access list value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - access-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterAccessList(mkey string) (output *JSONRouterAccessList, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/access-list"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONRouterAccessList{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterAccessList(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListRouterAccessLists API operation for FortiOS gets all the access lists for route filtering.
+// Returns the access lists when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - access-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListRouterAccessLists() (output []*JSONRouterAccessList, err error) {
+	results, err := c.listCmdbTable("router/access-list")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterAccessList, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONRouterAccessList{}
+		fillRouterAccessList(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillRouterAccessList fills output from a access list of the response
+func fillRouterAccessList(output *JSONRouterAccessList, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+	if mapTmp["rule"] != nil {
+		member := mapTmp["rule"].([]interface{})
+
+		var members []RouterAccessListRule
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := RouterAccessListRule{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["action"] != nil {
+				m.Action = c["action"].(string)
+			}
+			if c["prefix"] != nil {
+				m.Prefix = c["prefix"].(string)
+			}
+			if c["wildcard"] != nil {
+				m.Wildcard = c["wildcard"].(string)
+			}
+			if c["exact-match"] != nil {
+				m.ExactMatch = c["exact-match"].(string)
+			}
+			members = append(members, m)
+		}
+		output.Rule = members
+	}
+}
+
+// normalize returns a copy of the access list with its prefixes in the FortiOS notation,
+// after checking the actions of the rules
+func (l *JSONRouterAccessList) normalize() (*JSONRouterAccessList, error) {
+	n := *l
+
+	n.Rule = make([]RouterAccessListRule, len(l.Rule))
+	for i, r := range l.Rule {
+		switch r.Action {
+		case "", "permit", "deny":
+		default:
+			return nil, fmt.Errorf("access list %s: rule %d: invalid action %q", n.Name, r.ID, r.Action)
+		}
+
+		if r.Prefix != "any" && r.Prefix != "" {
+			prefix, err := NormalizeSubnet(r.Prefix)
+			if err != nil {
+				return nil, fmt.Errorf("access list %s: rule %d: %s", n.Name, r.ID, err)
+			}
+			r.Prefix = prefix
+		}
+
+		n.Rule[i] = r
+	}
+
+	return &n, nil
+}
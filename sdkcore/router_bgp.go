@@ -0,0 +1,289 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterBgp contains the parameters for Update API function
+// router/bgp is a single object holding the neighbors, the neighbor groups and the networks.
+// Read returns them, Update does not send them and leaves them unchanged, use the RouterBgpNeighbor,
+// RouterBgpNeighborGroup and RouterBgpNetwork API functions to change them.
+type JSONRouterBgp struct {
+	As                  int                          `json:"as"`
+	RouterID            string                       `json:"router-id"`
+	KeepaliveTimer      int                          `json:"keepalive-timer,omitempty"`
+	HoldtimeTimer       int                          `json:"holdtime-timer,omitempty"`
+	EbgpMultipath       string                       `json:"ebgp-multipath,omitempty"`
+	IbgpMultipath       string                       `json:"ibgp-multipath,omitempty"`
+	LogNeighbourChanges string                       `json:"log-neighbour-changes,omitempty"`
+	GracefulRestart     string                       `json:"graceful-restart,omitempty"`
+	Neighbor            []JSONRouterBgpNeighbor      `json:"neighbor,omitempty"`
+	NeighborGroup       []JSONRouterBgpNeighborGroup `json:"neighbor-group,omitempty"`
+	Network             []JSONRouterBgpNetwork       `json:"network,omitempty"`
+	Redistribute        []RouterBgpRedistribute      `json:"redistribute,omitempty"`
+}
+
+// RouterBgpRedistribute contains the redistribution of the routes of a protocol into BGP
+// Name is "connected", "static", "rip", "ospf" or "isis".
+type RouterBgpRedistribute struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	RouteMap string `json:"route-map"`
+}
+
+// JSONUpdateRouterBgpOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateRouterBgpOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// UpdateRouterBgp API operation for FortiOS updates the BGP configuration.
+// router/bgp is a singleton, mkey is not used. The neighbors, the neighbor groups and the networks
+// are not sent, see UpdateRouterBgpNeighbor, UpdateRouterBgpNeighborGroup and UpdateRouterBgpNetwork.
+// Returns the index value of the BGP configuration and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterBgp(params *JSONRouterBgp, mkey string) (output *JSONUpdateRouterBgpOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/bgp"
+	// path += "/" + mkey
+	output = &JSONUpdateRouterBgpOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterBgp API operation for FortiOS gets the BGP configuration.
+// router/bgp is a singleton, mkey is not used.
+// Returns the requested BGP configuration value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterBgp(mkey string) (output *JSONRouterBgp, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/bgp"
+	// path += "/" + mkey
+
+	output = &JSONRouterBgp{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp, _ := result["results"].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterBgp(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// fillRouterBgp fills output from the BGP configuration of the response
+func fillRouterBgp(output *JSONRouterBgp, mapTmp map[string]interface{}) {
+	if mapTmp["as"] != nil {
+		output.As = int(mapTmp["as"].(float64))
+	}
+	if mapTmp["router-id"] != nil {
+		output.RouterID = mapTmp["router-id"].(string)
+	}
+	if mapTmp["keepalive-timer"] != nil {
+		output.KeepaliveTimer = int(mapTmp["keepalive-timer"].(float64))
+	}
+	if mapTmp["holdtime-timer"] != nil {
+		output.HoldtimeTimer = int(mapTmp["holdtime-timer"].(float64))
+	}
+	if mapTmp["ebgp-multipath"] != nil {
+		output.EbgpMultipath = mapTmp["ebgp-multipath"].(string)
+	}
+	if mapTmp["ibgp-multipath"] != nil {
+		output.IbgpMultipath = mapTmp["ibgp-multipath"].(string)
+	}
+	if mapTmp["log-neighbour-changes"] != nil {
+		output.LogNeighbourChanges = mapTmp["log-neighbour-changes"].(string)
+	}
+	if mapTmp["graceful-restart"] != nil {
+		output.GracefulRestart = mapTmp["graceful-restart"].(string)
+	}
+	if mapTmp["neighbor"] != nil {
+		for _, v := range mapTmp["neighbor"].([]interface{}) {
+			m := JSONRouterBgpNeighbor{}
+			fillRouterBgpNeighbor(&m, v.(map[string]interface{}))
+			output.Neighbor = append(output.Neighbor, m)
+		}
+	}
+	if mapTmp["neighbor-group"] != nil {
+		for _, v := range mapTmp["neighbor-group"].([]interface{}) {
+			m := JSONRouterBgpNeighborGroup{}
+			fillRouterBgpNeighborGroup(&m, v.(map[string]interface{}))
+			output.NeighborGroup = append(output.NeighborGroup, m)
+		}
+	}
+	if mapTmp["network"] != nil {
+		for _, v := range mapTmp["network"].([]interface{}) {
+			m := JSONRouterBgpNetwork{}
+			fillRouterBgpNetwork(&m, v.(map[string]interface{}))
+			output.Network = append(output.Network, m)
+		}
+	}
+	if mapTmp["redistribute"] != nil {
+		member := mapTmp["redistribute"].([]interface{})
+
+		var members []RouterBgpRedistribute
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := RouterBgpRedistribute{}
+			if c["name"] != nil {
+				m.Name = c["name"].(string)
+			}
+			if c["status"] != nil {
+				m.Status = c["status"].(string)
+			}
+			if c["route-map"] != nil {
+				m.RouteMap = c["route-map"].(string)
+			}
+			members = append(members, m)
+		}
+		output.Redistribute = members
+	}
+}
+
+// normalize returns a copy of the BGP configuration without its neighbors, its neighbor groups
+// and its networks, which have their own API functions
+func (b *JSONRouterBgp) normalize() (*JSONRouterBgp, error) {
+	n := *b
+
+	n.Neighbor = nil
+	n.NeighborGroup = nil
+	n.Network = nil
+
+	return &n, nil
+}
@@ -0,0 +1,148 @@
+package forticlient
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// JSONRouterBgpNeighborStatus contains the session state of a BGP neighbor
+// State is the BGP finite state machine state, such as "Established" or "Active".
+type JSONRouterBgpNeighborStatus struct {
+	NeighborIP  string
+	LocalIP     string
+	RemoteAs    int
+	AdminStatus bool
+	State       string
+	Type        string
+}
+
+// JSONRouterBgpPath contains a path of the BGP table
+// Prefix is in the "10.0.0.0/24" notation, Best is true for the path installed in the routing table.
+type JSONRouterBgpPath struct {
+	Prefix      string
+	NextHop     string
+	LearnedFrom string
+	Origin      string
+	AsPath      string
+	LocalPref   int
+	Med         int
+	Best        bool
+}
+
+// ReadRouterBgpNeighborStatus API operation for FortiOS gets the session state of all the BGP neighbors.
+// Returns the state of each neighbor when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the get router info bgp summary chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterBgpNeighborStatus() (output []*JSONRouterBgpNeighborStatus, err error) {
+	results, err := c.readRouterBgpMonitor("/api/v2/monitor/router/bgp/neighbors", 0, 0)
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterBgpNeighborStatus, 0, len(results))
+	for _, mapTmp := range results {
+		s := &JSONRouterBgpNeighborStatus{}
+		if mapTmp["neighbor_ip"] != nil {
+			s.NeighborIP = mapTmp["neighbor_ip"].(string)
+		}
+		if mapTmp["local_ip"] != nil {
+			s.LocalIP = mapTmp["local_ip"].(string)
+		}
+		if mapTmp["remote_as"] != nil {
+			s.RemoteAs = int(mapTmp["remote_as"].(float64))
+		}
+		if mapTmp["admin_status"] != nil {
+			s.AdminStatus = mapTmp["admin_status"].(bool)
+		}
+		if mapTmp["state"] != nil {
+			s.State = mapTmp["state"].(string)
+		}
+		if mapTmp["type"] != nil {
+			s.Type = mapTmp["type"].(string)
+		}
+
+		output = append(output, s)
+	}
+
+	return
+}
+
+// ReadRouterBgpPaths API operation for FortiOS gets the paths of the BGP table,
+// from the path at index start. A count of 0 gets all the paths, a full Internet table
+// holds hundreds of thousands of them.
+// Returns the paths when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the get router info bgp network chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterBgpPaths(start int, count int) (output []*JSONRouterBgpPath, err error) {
+	results, err := c.readRouterBgpMonitor("/api/v2/monitor/router/bgp/paths", start, count)
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterBgpPath, 0, len(results))
+	for _, mapTmp := range results {
+		p := &JSONRouterBgpPath{}
+		if mapTmp["nlri_prefix"] != nil {
+			p.Prefix = mapTmp["nlri_prefix"].(string)
+			if mapTmp["nlri_prefix_len"] != nil {
+				p.Prefix += "/" + strconv.Itoa(int(mapTmp["nlri_prefix_len"].(float64)))
+			}
+		}
+		if mapTmp["next_hop"] != nil {
+			p.NextHop = mapTmp["next_hop"].(string)
+		}
+		if mapTmp["learned_from"] != nil {
+			p.LearnedFrom = mapTmp["learned_from"].(string)
+		}
+		if mapTmp["origin"] != nil {
+			p.Origin = fmt.Sprint(mapTmp["origin"])
+		}
+		if mapTmp["as_path"] != nil {
+			p.AsPath = fmt.Sprint(mapTmp["as_path"])
+		}
+		if mapTmp["local_pref"] != nil {
+			p.LocalPref = int(mapTmp["local_pref"].(float64))
+		}
+		if mapTmp["med"] != nil {
+			p.Med = int(mapTmp["med"].(float64))
+		}
+		if mapTmp["is_best"] != nil {
+			p.Best = mapTmp["is_best"].(bool)
+		}
+
+		output = append(output, p)
+	}
+
+	return
+}
+
+// readRouterBgpMonitor gets the entries of a BGP monitor, start and count are only sent when count is set
+func (c *FortiSDKClient) readRouterBgpMonitor(path string, start int, count int) (output []map[string]interface{}, err error) {
+	HTTPMethod := "GET"
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	if count > 0 {
+		req.FillUrlParam("start", strconv.Itoa(start))
+		req.FillUrlParam("count", strconv.Itoa(count))
+	}
+
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	results, ok := result["results"].([]interface{})
+	if !ok {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	output = make([]map[string]interface{}, 0, len(results))
+	for _, v := range results {
+		if mapTmp, ok := v.(map[string]interface{}); ok {
+			output = append(output, mapTmp)
+		}
+	}
+
+	return
+}
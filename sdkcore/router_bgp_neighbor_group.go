@@ -0,0 +1,399 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterBgpNeighborGroup contains the parameters for Create and Update API function
+// The neighbor group is an entry of the router/bgp table, its API functions change one group
+// without sending the other ones.
+// Password is write only, Update keeps the current password when it is empty.
+type JSONRouterBgpNeighborGroup struct {
+	Name                string `json:"name"`
+	RemoteAs            int    `json:"remote-as"`
+	Description         string `json:"description,omitempty"`
+	Interface           string `json:"interface,omitempty"`
+	UpdateSource        string `json:"update-source,omitempty"`
+	EbgpEnforceMultihop string `json:"ebgp-enforce-multihop,omitempty"`
+	NextHopSelf         string `json:"next-hop-self,omitempty"`
+	SoftReconfiguration string `json:"soft-reconfiguration,omitempty"`
+	RouteMapIn          string `json:"route-map-in"`
+	RouteMapOut         string `json:"route-map-out"`
+	PrefixListIn        string `json:"prefix-list-in"`
+	PrefixListOut       string `json:"prefix-list-out"`
+	Password            string `json:"password,omitempty"`
+	Weight              int    `json:"weight,omitempty"`
+	Activate            string `json:"activate,omitempty"`
+}
+
+// JSONCreateRouterBgpNeighborGroupOutput contains the output results for Create API function
+type JSONCreateRouterBgpNeighborGroupOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateRouterBgpNeighborGroupOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateRouterBgpNeighborGroupOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateRouterBgpNeighborGroup API operation for FortiOS creates a new BGP neighbor group, the settings shared by the neighbors of a neighbor range.
+// Returns the index value of the BGP neighbor group and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateRouterBgpNeighborGroup(params *JSONRouterBgpNeighborGroup) (output *JSONCreateRouterBgpNeighborGroupOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/bgp/neighbor-group"
+	output = &JSONCreateRouterBgpNeighborGroupOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateRouterBgpNeighborGroup API operation for FortiOS updates the specified BGP neighbor group, the settings shared by the neighbors of a neighbor range.
+// Returns the index value of the BGP neighbor group and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterBgpNeighborGroup(params *JSONRouterBgpNeighborGroup, mkey string) (output *JSONUpdateRouterBgpNeighborGroupOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/bgp/neighbor-group"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateRouterBgpNeighborGroupOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteRouterBgpNeighborGroup API operation for FortiOS deletes the specified BGP neighbor group, the settings shared by the neighbors of a neighbor range.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteRouterBgpNeighborGroup(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/bgp/neighbor-group"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterBgpNeighborGroup API operation for FortiOS gets the BGP neighbor group, the settings shared by the neighbors of a neighbor range
+// with the specified index value.
+// Returns the requested BGP neighbor group value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterBgpNeighborGroup(mkey string) (output *JSONRouterBgpNeighborGroup, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/bgp/neighbor-group"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONRouterBgpNeighborGroup{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterBgpNeighborGroup(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListRouterBgpNeighborGroups API operation for FortiOS gets all the BGP neighbor groups, the settings shared by the neighbors of a neighbor range.
+// Returns the BGP neighbor groups when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListRouterBgpNeighborGroups() (output []*JSONRouterBgpNeighborGroup, err error) {
+	results, err := c.listCmdbTable("router/bgp/neighbor-group")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterBgpNeighborGroup, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONRouterBgpNeighborGroup{}
+		fillRouterBgpNeighborGroup(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillRouterBgpNeighborGroup fills output from a BGP neighbor group of the response
+func fillRouterBgpNeighborGroup(output *JSONRouterBgpNeighborGroup, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["remote-as"] != nil {
+		output.RemoteAs = int(mapTmp["remote-as"].(float64))
+	}
+	if mapTmp["description"] != nil {
+		output.Description = mapTmp["description"].(string)
+	}
+	if mapTmp["interface"] != nil {
+		output.Interface = mapTmp["interface"].(string)
+	}
+	if mapTmp["update-source"] != nil {
+		output.UpdateSource = mapTmp["update-source"].(string)
+	}
+	if mapTmp["ebgp-enforce-multihop"] != nil {
+		output.EbgpEnforceMultihop = mapTmp["ebgp-enforce-multihop"].(string)
+	}
+	if mapTmp["next-hop-self"] != nil {
+		output.NextHopSelf = mapTmp["next-hop-self"].(string)
+	}
+	if mapTmp["soft-reconfiguration"] != nil {
+		output.SoftReconfiguration = mapTmp["soft-reconfiguration"].(string)
+	}
+	if mapTmp["route-map-in"] != nil {
+		output.RouteMapIn = mapTmp["route-map-in"].(string)
+	}
+	if mapTmp["route-map-out"] != nil {
+		output.RouteMapOut = mapTmp["route-map-out"].(string)
+	}
+	if mapTmp["prefix-list-in"] != nil {
+		output.PrefixListIn = mapTmp["prefix-list-in"].(string)
+	}
+	if mapTmp["prefix-list-out"] != nil {
+		output.PrefixListOut = mapTmp["prefix-list-out"].(string)
+	}
+	if mapTmp["weight"] != nil {
+		output.Weight = int(mapTmp["weight"].(float64))
+	}
+	if mapTmp["activate"] != nil {
+		output.Activate = mapTmp["activate"].(string)
+	}
+}
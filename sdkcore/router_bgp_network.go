@@ -0,0 +1,381 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterBgpNetwork contains the parameters for Create and Update API function
+// Prefix accepts the "10.0.0.0/24" and the "10.0.0.0 255.255.255.0" notations.
+type JSONRouterBgpNetwork struct {
+	ID       int    `json:"id,omitempty"`
+	Prefix   string `json:"prefix"`
+	RouteMap string `json:"route-map"`
+	Backdoor string `json:"backdoor,omitempty"`
+}
+
+// JSONCreateRouterBgpNetworkOutput contains the output results for Create API function
+type JSONCreateRouterBgpNetworkOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateRouterBgpNetworkOutput contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateRouterBgpNetworkOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateRouterBgpNetwork API operation for FortiOS creates a new BGP network announced to the neighbors.
+// Returns the index value of the BGP network and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateRouterBgpNetwork(params *JSONRouterBgpNetwork) (output *JSONCreateRouterBgpNetworkOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/bgp/network"
+	output = &JSONCreateRouterBgpNetworkOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateRouterBgpNetwork API operation for FortiOS updates the specified BGP network announced to the neighbors.
+// Returns the index value of the BGP network and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterBgpNetwork(params *JSONRouterBgpNetwork, mkey string) (output *JSONUpdateRouterBgpNetworkOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/bgp/network"
+	path += "/" + mkey
+	output = &JSONUpdateRouterBgpNetworkOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteRouterBgpNetwork API operation for FortiOS deletes the specified BGP network announced to the neighbors.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteRouterBgpNetwork(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/bgp/network"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterBgpNetwork API operation for FortiOS gets the BGP network announced to the neighbors
+// with the specified index value.
+// Returns the requested BGP network value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterBgpNetwork(mkey string) (output *JSONRouterBgpNetwork, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/bgp/network"
+	path += "/" + mkey
+
+	output = &JSONRouterBgpNetwork{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterBgpNetwork(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListRouterBgpNetworks API operation for FortiOS gets all the BGP networks announced to the neighbors.
+// Returns the BGP networks when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - bgp chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListRouterBgpNetworks() (output []*JSONRouterBgpNetwork, err error) {
+	results, err := c.listCmdbTable("router/bgp/network")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterBgpNetwork, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONRouterBgpNetwork{}
+		fillRouterBgpNetwork(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillRouterBgpNetwork fills output from a BGP network of the response
+func fillRouterBgpNetwork(output *JSONRouterBgpNetwork, mapTmp map[string]interface{}) {
+	if mapTmp["id"] != nil {
+		output.ID = int(mapTmp["id"].(float64))
+	}
+	if mapTmp["prefix"] != nil {
+		output.Prefix = mapTmp["prefix"].(string)
+	}
+	if mapTmp["route-map"] != nil {
+		output.RouteMap = mapTmp["route-map"].(string)
+	}
+	if mapTmp["backdoor"] != nil {
+		output.Backdoor = mapTmp["backdoor"].(string)
+	}
+}
+
+// normalize returns a copy of the network with its prefix in the FortiOS notation
+func (n *JSONRouterBgpNetwork) normalize() (*JSONRouterBgpNetwork, error) {
+	v := *n
+
+	prefix, err := NormalizeSubnet(v.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("BGP network %d: %s", v.ID, err)
+	}
+	v.Prefix = prefix
+
+	return &v, nil
+}
@@ -0,0 +1,62 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+)
+
+func TestUpdateRouterBgpKeepsTables(t *testing.T) {
+	var sent map[string]interface{}
+
+	device := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// router/bgp is a singleton, its results are an object
+		reply := map[string]interface{}{"status": "success", "http_status": 200, "results": map[string]interface{}{
+			"as": 65001.0, "router-id": "192.0.2.1",
+			"neighbor":       []interface{}{map[string]interface{}{"ip": "192.0.2.2", "remote-as": 65002.0}},
+			"neighbor-group": []interface{}{map[string]interface{}{"name": "peers", "remote-as": 65003.0}},
+			"network":        []interface{}{map[string]interface{}{"id": 1.0, "prefix": "10.0.0.0 255.255.255.0"}},
+		}}
+		if r.Method == "PUT" {
+			json.NewDecoder(r.Body).Decode(&sent)
+			reply = map[string]interface{}{"status": "success", "http_status": 200}
+		}
+		json.NewEncoder(w).Encode(reply)
+	}))
+	defer device.Close()
+
+	u, err := url.Parse(device.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client())
+
+	bgp, err := c.ReadRouterBgp("")
+	if err != nil {
+		t.Fatalf("ReadRouterBgp() error = %v", err)
+	}
+	if len(bgp.Neighbor) != 1 || len(bgp.NeighborGroup) != 1 || len(bgp.Network) != 1 {
+		t.Fatalf("ReadRouterBgp() = %+v, want the neighbor, the neighbor group and the network", bgp)
+	}
+
+	bgp.KeepaliveTimer = 30
+	if _, err := c.UpdateRouterBgp(bgp, ""); err != nil {
+		t.Fatalf("UpdateRouterBgp() error = %v", err)
+	}
+
+	for _, k := range []string{"neighbor", "neighbor-group", "network"} {
+		if _, ok := sent[k]; ok {
+			t.Errorf("UpdateRouterBgp() sent %s", k)
+		}
+	}
+	if sent["as"] != 65001.0 || sent["keepalive-timer"] != 30.0 {
+		t.Errorf("UpdateRouterBgp() sent %v, want the BGP settings", sent)
+	}
+	if len(bgp.Neighbor) != 1 {
+		t.Error("UpdateRouterBgp() changed the neighbors of the parameters")
+	}
+}
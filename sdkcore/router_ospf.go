@@ -0,0 +1,305 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterOspf contains the parameters for Update API function
+// router/ospf is a single object holding the areas, the OSPF interfaces and the networks.
+// Update leaves the lists which are nil unchanged, use the RouterOspfArea, RouterOspfOspfInterface
+// and RouterOspfNetwork API functions to change one entry without sending the whole list.
+type JSONRouterOspf struct {
+	RouterID                    string                        `json:"router-id"`
+	AbrType                     string                        `json:"abr-type,omitempty"`
+	AutoCostRefBandwidth        int                           `json:"auto-cost-ref-bandwidth,omitempty"`
+	DefaultInformationOriginate string                        `json:"default-information-originate,omitempty"`
+	LogNeighbourChanges         string                        `json:"log-neighbour-changes,omitempty"`
+	Area                        []JSONRouterOspfArea          `json:"area,omitempty"`
+	OspfInterface               []JSONRouterOspfOspfInterface `json:"ospf-interface,omitempty"`
+	Network                     []JSONRouterOspfNetwork       `json:"network,omitempty"`
+	Redistribute                []RouterOspfRedistribute      `json:"redistribute,omitempty"`
+}
+
+// RouterOspfRedistribute contains the redistribution of the routes of a protocol into OSPF
+// Name is "connected", "static", "rip", "bgp" or "isis", MetricType is "1" or "2".
+type RouterOspfRedistribute struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Metric     int    `json:"metric,omitempty"`
+	MetricType string `json:"metric-type,omitempty"`
+	Routemap   string `json:"routemap"`
+	Tag        int    `json:"tag,omitempty"`
+}
+
+// JSONUpdateRouterOspfOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateRouterOspfOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// UpdateRouterOspf API operation for FortiOS updates the OSPF configuration.
+// router/ospf is a singleton, mkey is not used.
+// Returns the index value of the OSPF configuration and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterOspf(params *JSONRouterOspf, mkey string) (output *JSONUpdateRouterOspfOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/ospf"
+	// path += "/" + mkey
+	output = &JSONUpdateRouterOspfOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterOspf API operation for FortiOS gets the OSPF configuration.
+// router/ospf is a singleton, mkey is not used.
+// Returns the requested OSPF configuration value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterOspf(mkey string) (output *JSONRouterOspf, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/ospf"
+	// path += "/" + mkey
+
+	output = &JSONRouterOspf{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp, _ := result["results"].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterOspf(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// fillRouterOspf fills output from the OSPF configuration of the response
+func fillRouterOspf(output *JSONRouterOspf, mapTmp map[string]interface{}) {
+	if mapTmp["router-id"] != nil {
+		output.RouterID = mapTmp["router-id"].(string)
+	}
+	if mapTmp["abr-type"] != nil {
+		output.AbrType = mapTmp["abr-type"].(string)
+	}
+	if mapTmp["auto-cost-ref-bandwidth"] != nil {
+		output.AutoCostRefBandwidth = int(mapTmp["auto-cost-ref-bandwidth"].(float64))
+	}
+	if mapTmp["default-information-originate"] != nil {
+		output.DefaultInformationOriginate = mapTmp["default-information-originate"].(string)
+	}
+	if mapTmp["log-neighbour-changes"] != nil {
+		output.LogNeighbourChanges = mapTmp["log-neighbour-changes"].(string)
+	}
+	if mapTmp["area"] != nil {
+		for _, v := range mapTmp["area"].([]interface{}) {
+			m := JSONRouterOspfArea{}
+			fillRouterOspfArea(&m, v.(map[string]interface{}))
+			output.Area = append(output.Area, m)
+		}
+	}
+	if mapTmp["ospf-interface"] != nil {
+		for _, v := range mapTmp["ospf-interface"].([]interface{}) {
+			m := JSONRouterOspfOspfInterface{}
+			fillRouterOspfOspfInterface(&m, v.(map[string]interface{}))
+			output.OspfInterface = append(output.OspfInterface, m)
+		}
+	}
+	if mapTmp["network"] != nil {
+		for _, v := range mapTmp["network"].([]interface{}) {
+			m := JSONRouterOspfNetwork{}
+			fillRouterOspfNetwork(&m, v.(map[string]interface{}))
+			output.Network = append(output.Network, m)
+		}
+	}
+	if mapTmp["redistribute"] != nil {
+		member := mapTmp["redistribute"].([]interface{})
+
+		var members []RouterOspfRedistribute
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := RouterOspfRedistribute{}
+			if c["name"] != nil {
+				m.Name = c["name"].(string)
+			}
+			if c["status"] != nil {
+				m.Status = c["status"].(string)
+			}
+			if c["metric"] != nil {
+				m.Metric = int(c["metric"].(float64))
+			}
+			if c["metric-type"] != nil {
+				m.MetricType = c["metric-type"].(string)
+			}
+			if c["routemap"] != nil {
+				m.Routemap = c["routemap"].(string)
+			}
+			if c["tag"] != nil {
+				m.Tag = int(c["tag"].(float64))
+			}
+			members = append(members, m)
+		}
+		output.Redistribute = members
+	}
+}
+
+// normalize returns a copy of the OSPF configuration after normalizing its areas and its networks
+func (o *JSONRouterOspf) normalize() (*JSONRouterOspf, error) {
+	n := *o
+
+	if n.Area != nil {
+		n.Area = make([]JSONRouterOspfArea, len(o.Area))
+		for i := range o.Area {
+			v, err := o.Area[i].normalize()
+			if err != nil {
+				return nil, err
+			}
+			n.Area[i] = *v
+		}
+	}
+
+	if n.Network != nil {
+		n.Network = make([]JSONRouterOspfNetwork, len(o.Network))
+		for i := range o.Network {
+			v, err := o.Network[i].normalize()
+			if err != nil {
+				return nil, err
+			}
+			n.Network[i] = *v
+		}
+	}
+
+	return &n, nil
+}
@@ -0,0 +1,408 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/netip"
+	"strconv"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterOspfArea contains the parameters for Create and Update API function
+// The area is an entry of the router/ospf table, its API functions change one area
+// without sending the other ones. ID is the area in the dotted notation, such as "0.0.0.0",
+// a decimal area number is converted. Type is "regular", "stub" or "nssa".
+type JSONRouterOspfArea struct {
+	ID                 string `json:"id"`
+	Type               string `json:"type"`
+	Authentication     string `json:"authentication,omitempty"`
+	DefaultCost        int    `json:"default-cost,omitempty"`
+	NssaTranslatorRole string `json:"nssa-translator-role,omitempty"`
+}
+
+// JSONCreateRouterOspfAreaOutput contains the output results for Create API function
+type JSONCreateRouterOspfAreaOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateRouterOspfAreaOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateRouterOspfAreaOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateRouterOspfArea API operation for FortiOS creates a new OSPF area.
+// Returns the index value of the OSPF area and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateRouterOspfArea(params *JSONRouterOspfArea) (output *JSONCreateRouterOspfAreaOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/ospf/area"
+	output = &JSONCreateRouterOspfAreaOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateRouterOspfArea API operation for FortiOS updates the specified OSPF area.
+// Returns the index value of the OSPF area and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterOspfArea(params *JSONRouterOspfArea, mkey string) (output *JSONUpdateRouterOspfAreaOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/ospf/area"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateRouterOspfAreaOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteRouterOspfArea API operation for FortiOS deletes the specified OSPF area.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteRouterOspfArea(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/ospf/area"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterOspfArea API operation for FortiOS gets the OSPF area
+// with the specified index value.
+// Returns the requested OSPF area value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterOspfArea(mkey string) (output *JSONRouterOspfArea, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/ospf/area"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONRouterOspfArea{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterOspfArea(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListRouterOspfAreas API operation for FortiOS gets all the OSPF areas.
+// Returns the OSPF areas when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListRouterOspfAreas() (output []*JSONRouterOspfArea, err error) {
+	results, err := c.listCmdbTable("router/ospf/area")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterOspfArea, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONRouterOspfArea{}
+		fillRouterOspfArea(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillRouterOspfArea fills output from a OSPF area of the response
+func fillRouterOspfArea(output *JSONRouterOspfArea, mapTmp map[string]interface{}) {
+	if mapTmp["id"] != nil {
+		output.ID = mapTmp["id"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["authentication"] != nil {
+		output.Authentication = mapTmp["authentication"].(string)
+	}
+	if mapTmp["default-cost"] != nil {
+		output.DefaultCost = int(mapTmp["default-cost"].(float64))
+	}
+	if mapTmp["nssa-translator-role"] != nil {
+		output.NssaTranslatorRole = mapTmp["nssa-translator-role"].(string)
+	}
+}
+
+// normalize returns a copy of the area with its ID in the dotted notation, after checking its type
+func (a *JSONRouterOspfArea) normalize() (*JSONRouterOspfArea, error) {
+	n := *a
+
+	id, err := ospfAreaID(n.ID)
+	if err != nil {
+		return nil, err
+	}
+	n.ID = id
+
+	switch n.Type {
+	case "", "regular", "stub", "nssa":
+	default:
+		return nil, fmt.Errorf("OSPF area %s: invalid type %q", n.ID, n.Type)
+	}
+
+	return &n, nil
+}
+
+// ospfAreaID converts an area in the dotted or the decimal notation to the dotted notation
+func ospfAreaID(s string) (string, error) {
+	if v, err := strconv.ParseUint(s, 10, 32); err == nil {
+		return netip.AddrFrom4([4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}).String(), nil
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil || !addr.Is4() {
+		return "", fmt.Errorf("invalid OSPF area %q", s)
+	}
+	return addr.String(), nil
+}
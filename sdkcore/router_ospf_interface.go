@@ -0,0 +1,391 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterOspfOspfInterface contains the parameters for Create and Update API function
+// The OSPF interface is an entry of the router/ospf table, its API functions change one
+// interface without sending the other ones.
+// AuthenticationKey is write only, Update keeps the current key when it is empty.
+type JSONRouterOspfOspfInterface struct {
+	Name              string `json:"name"`
+	Interface         string `json:"interface"`
+	IP                string `json:"ip,omitempty"`
+	Status            string `json:"status,omitempty"`
+	NetworkType       string `json:"network-type,omitempty"`
+	Cost              int    `json:"cost,omitempty"`
+	Priority          int    `json:"priority,omitempty"`
+	HelloInterval     int    `json:"hello-interval,omitempty"`
+	DeadInterval      int    `json:"dead-interval,omitempty"`
+	Authentication    string `json:"authentication,omitempty"`
+	AuthenticationKey string `json:"authentication-key,omitempty"`
+	MtuIgnore         string `json:"mtu-ignore,omitempty"`
+	Bfd               string `json:"bfd,omitempty"`
+}
+
+// JSONCreateRouterOspfOspfInterfaceOutput contains the output results for Create API function
+type JSONCreateRouterOspfOspfInterfaceOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateRouterOspfOspfInterfaceOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateRouterOspfOspfInterfaceOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateRouterOspfOspfInterface API operation for FortiOS creates a new OSPF interface, the OSPF settings of an interface.
+// Returns the index value of the OSPF interface and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateRouterOspfOspfInterface(params *JSONRouterOspfOspfInterface) (output *JSONCreateRouterOspfOspfInterfaceOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/ospf/ospf-interface"
+	output = &JSONCreateRouterOspfOspfInterfaceOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateRouterOspfOspfInterface API operation for FortiOS updates the specified OSPF interface, the OSPF settings of an interface.
+// Returns the index value of the OSPF interface and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterOspfOspfInterface(params *JSONRouterOspfOspfInterface, mkey string) (output *JSONUpdateRouterOspfOspfInterfaceOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/ospf/ospf-interface"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateRouterOspfOspfInterfaceOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteRouterOspfOspfInterface API operation for FortiOS deletes the specified OSPF interface, the OSPF settings of an interface.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteRouterOspfOspfInterface(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/ospf/ospf-interface"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterOspfOspfInterface API operation for FortiOS gets the OSPF interface, the OSPF settings of an interface
+// with the specified index value.
+// Returns the requested OSPF interface value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterOspfOspfInterface(mkey string) (output *JSONRouterOspfOspfInterface, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/ospf/ospf-interface"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONRouterOspfOspfInterface{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterOspfOspfInterface(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListRouterOspfOspfInterfaces API operation for FortiOS gets all the OSPF interfaces, the OSPF settings of their interface.
+// Returns the OSPF interfaces when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListRouterOspfOspfInterfaces() (output []*JSONRouterOspfOspfInterface, err error) {
+	results, err := c.listCmdbTable("router/ospf/ospf-interface")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterOspfOspfInterface, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONRouterOspfOspfInterface{}
+		fillRouterOspfOspfInterface(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillRouterOspfOspfInterface fills output from a OSPF interface of the response
+func fillRouterOspfOspfInterface(output *JSONRouterOspfOspfInterface, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["interface"] != nil {
+		output.Interface = mapTmp["interface"].(string)
+	}
+	if mapTmp["ip"] != nil {
+		output.IP = mapTmp["ip"].(string)
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+	if mapTmp["network-type"] != nil {
+		output.NetworkType = mapTmp["network-type"].(string)
+	}
+	if mapTmp["cost"] != nil {
+		output.Cost = int(mapTmp["cost"].(float64))
+	}
+	if mapTmp["priority"] != nil {
+		output.Priority = int(mapTmp["priority"].(float64))
+	}
+	if mapTmp["hello-interval"] != nil {
+		output.HelloInterval = int(mapTmp["hello-interval"].(float64))
+	}
+	if mapTmp["dead-interval"] != nil {
+		output.DeadInterval = int(mapTmp["dead-interval"].(float64))
+	}
+	if mapTmp["authentication"] != nil {
+		output.Authentication = mapTmp["authentication"].(string)
+	}
+	if mapTmp["mtu-ignore"] != nil {
+		output.MtuIgnore = mapTmp["mtu-ignore"].(string)
+	}
+	if mapTmp["bfd"] != nil {
+		output.Bfd = mapTmp["bfd"].(string)
+	}
+}
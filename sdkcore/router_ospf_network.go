@@ -0,0 +1,384 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterOspfNetwork contains the parameters for Create and Update API function
+// Prefix accepts the "10.0.0.0/24" and the "10.0.0.0 255.255.255.0" notations,
+// Area accepts the dotted and the decimal notations.
+type JSONRouterOspfNetwork struct {
+	ID     int    `json:"id,omitempty"`
+	Prefix string `json:"prefix"`
+	Area   string `json:"area"`
+}
+
+// JSONCreateRouterOspfNetworkOutput contains the output results for Create API function
+type JSONCreateRouterOspfNetworkOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateRouterOspfNetworkOutput contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateRouterOspfNetworkOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateRouterOspfNetwork API operation for FortiOS creates a new OSPF network, the prefix enabling OSPF on the interfaces in it.
+// Returns the index value of the OSPF network and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateRouterOspfNetwork(params *JSONRouterOspfNetwork) (output *JSONCreateRouterOspfNetworkOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/ospf/network"
+	output = &JSONCreateRouterOspfNetworkOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateRouterOspfNetwork API operation for FortiOS updates the specified OSPF network, the prefix enabling OSPF on the interfaces in it.
+// Returns the index value of the OSPF network and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterOspfNetwork(params *JSONRouterOspfNetwork, mkey string) (output *JSONUpdateRouterOspfNetworkOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/ospf/network"
+	path += "/" + mkey
+	output = &JSONUpdateRouterOspfNetworkOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteRouterOspfNetwork API operation for FortiOS deletes the specified OSPF network, the prefix enabling OSPF on the interfaces in it.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteRouterOspfNetwork(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/ospf/network"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterOspfNetwork API operation for FortiOS gets the OSPF network, the prefix enabling OSPF on the interfaces in it
+// with the specified index value.
+// Returns the requested OSPF network value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterOspfNetwork(mkey string) (output *JSONRouterOspfNetwork, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/ospf/network"
+	path += "/" + mkey
+
+	output = &JSONRouterOspfNetwork{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterOspfNetwork(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListRouterOspfNetworks API operation for FortiOS gets all the OSPF networks, the prefixes enabling OSPF on the interfaces in them.
+// Returns the OSPF networks when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - ospf chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListRouterOspfNetworks() (output []*JSONRouterOspfNetwork, err error) {
+	results, err := c.listCmdbTable("router/ospf/network")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterOspfNetwork, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONRouterOspfNetwork{}
+		fillRouterOspfNetwork(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillRouterOspfNetwork fills output from a OSPF network of the response
+func fillRouterOspfNetwork(output *JSONRouterOspfNetwork, mapTmp map[string]interface{}) {
+	if mapTmp["id"] != nil {
+		output.ID = int(mapTmp["id"].(float64))
+	}
+	if mapTmp["prefix"] != nil {
+		output.Prefix = mapTmp["prefix"].(string)
+	}
+	if mapTmp["area"] != nil {
+		output.Area = mapTmp["area"].(string)
+	}
+}
+
+// normalize returns a copy of the network with its prefix and its area in the FortiOS notations
+func (n *JSONRouterOspfNetwork) normalize() (*JSONRouterOspfNetwork, error) {
+	v := *n
+
+	prefix, err := NormalizeSubnet(v.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("OSPF network %d: %s", v.ID, err)
+	}
+	v.Prefix = prefix
+
+	area, err := ospfAreaID(v.Area)
+	if err != nil {
+		return nil, fmt.Errorf("OSPF network %d: %s", v.ID, err)
+	}
+	v.Area = area
+
+	return &v, nil
+}
@@ -0,0 +1,544 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/netip"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterPolicy contains the parameters for Create and Update API function
+// The policy routes are evaluated in sequence before the routing table, use
+// CreateUpdateRouterPolicySeq to move one. Protocol 0 matches every protocol,
+// Action "deny" stops the evaluation and leaves the traffic to the routing table.
+type JSONRouterPolicy struct {
+	SeqNum          int                  `json:"seq-num,omitempty"`
+	Status          string               `json:"status"`
+	InputDevice     MultValues           `json:"input-device"`
+	Src             []RouterPolicySubnet `json:"src"`
+	Srcaddr         MultValues           `json:"srcaddr,omitempty"`
+	Dst             []RouterPolicySubnet `json:"dst"`
+	Dstaddr         MultValues           `json:"dstaddr,omitempty"`
+	Action          string               `json:"action,omitempty"`
+	Protocol        int                  `json:"protocol"`
+	StartPort       int                  `json:"start-port,omitempty"`
+	EndPort         int                  `json:"end-port,omitempty"`
+	StartSourcePort int                  `json:"start-source-port,omitempty"`
+	EndSourcePort   int                  `json:"end-source-port,omitempty"`
+	Gateway         string               `json:"gateway"`
+	OutputDevice    string               `json:"output-device"`
+	Tos             string               `json:"tos,omitempty"`
+	TosMask         string               `json:"tos-mask,omitempty"`
+	Comments        string               `json:"comments"`
+}
+
+// RouterPolicySubnet contains a source or destination subnet of a policy route
+// Subnet accepts the "10.0.0.0/24" and the "10.0.0.0 255.255.255.0" notations.
+type RouterPolicySubnet struct {
+	Subnet string `json:"subnet"`
+}
+
+// JSONCreateRouterPolicyOutput contains the output results for Create API function
+type JSONCreateRouterPolicyOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateRouterPolicyOutput contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateRouterPolicyOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateRouterPolicy API operation for FortiOS creates a new policy route.
+// Returns the index value of the policy route and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateRouterPolicy(params *JSONRouterPolicy) (output *JSONCreateRouterPolicyOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/policy"
+	output = &JSONCreateRouterPolicyOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateRouterPolicy API operation for FortiOS updates the specified policy route.
+// Returns the index value of the policy route and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterPolicy(params *JSONRouterPolicy, mkey string) (output *JSONUpdateRouterPolicyOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/policy"
+	path += "/" + mkey
+	output = &JSONUpdateRouterPolicyOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteRouterPolicy API operation for FortiOS deletes the specified policy route.
+// Returns error for service API and SDK errors.
+// See the router - policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteRouterPolicy(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/policy"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterPolicy API operation for FortiOS gets the policy route
+// with the specified index value.
+// Returns the requested policy route value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterPolicy(mkey string) (output *JSONRouterPolicy, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/policy"
+	path += "/" + mkey
+
+	output = &JSONRouterPolicy{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterPolicy(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListRouterPolicies API operation for FortiOS gets all the policy routes.
+// Returns the policy routes when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - policy chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListRouterPolicies() (output []*JSONRouterPolicy, err error) {
+	results, err := c.listCmdbTable("router/policy")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterPolicy, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONRouterPolicy{}
+		fillRouterPolicy(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillRouterPolicy fills output from a policy route of the response
+func fillRouterPolicy(output *JSONRouterPolicy, mapTmp map[string]interface{}) {
+	if mapTmp["seq-num"] != nil {
+		output.SeqNum = int(mapTmp["seq-num"].(float64))
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+	if mapTmp["input-device"] != nil {
+		member := mapTmp["input-device"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.InputDevice = members
+	}
+	if mapTmp["src"] != nil {
+		member := mapTmp["src"].([]interface{})
+
+		var members []RouterPolicySubnet
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := RouterPolicySubnet{}
+			if c["subnet"] != nil {
+				m.Subnet = c["subnet"].(string)
+			}
+			members = append(members, m)
+		}
+		output.Src = members
+	}
+	if mapTmp["srcaddr"] != nil {
+		member := mapTmp["srcaddr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Srcaddr = members
+	}
+	if mapTmp["dst"] != nil {
+		member := mapTmp["dst"].([]interface{})
+
+		var members []RouterPolicySubnet
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := RouterPolicySubnet{}
+			if c["subnet"] != nil {
+				m.Subnet = c["subnet"].(string)
+			}
+			members = append(members, m)
+		}
+		output.Dst = members
+	}
+	if mapTmp["dstaddr"] != nil {
+		member := mapTmp["dstaddr"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Dstaddr = members
+	}
+	if mapTmp["action"] != nil {
+		output.Action = mapTmp["action"].(string)
+	}
+	if mapTmp["protocol"] != nil {
+		output.Protocol = int(mapTmp["protocol"].(float64))
+	}
+	if mapTmp["start-port"] != nil {
+		output.StartPort = int(mapTmp["start-port"].(float64))
+	}
+	if mapTmp["end-port"] != nil {
+		output.EndPort = int(mapTmp["end-port"].(float64))
+	}
+	if mapTmp["start-source-port"] != nil {
+		output.StartSourcePort = int(mapTmp["start-source-port"].(float64))
+	}
+	if mapTmp["end-source-port"] != nil {
+		output.EndSourcePort = int(mapTmp["end-source-port"].(float64))
+	}
+	if mapTmp["gateway"] != nil {
+		output.Gateway = mapTmp["gateway"].(string)
+	}
+	if mapTmp["output-device"] != nil {
+		output.OutputDevice = mapTmp["output-device"].(string)
+	}
+	if mapTmp["tos"] != nil {
+		output.Tos = mapTmp["tos"].(string)
+	}
+	if mapTmp["tos-mask"] != nil {
+		output.TosMask = mapTmp["tos-mask"].(string)
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+}
+
+// normalize returns a copy of the policy route with its subnets in the FortiOS notation,
+// after checking its action, its protocol, its port ranges and its gateway
+func (p *JSONRouterPolicy) normalize() (*JSONRouterPolicy, error) {
+	n := *p
+
+	var err error
+	if n.Src, err = routerPolicySubnets(p.Src); err != nil {
+		return nil, fmt.Errorf("policy route %d: source: %s", n.SeqNum, err)
+	}
+	if n.Dst, err = routerPolicySubnets(p.Dst); err != nil {
+		return nil, fmt.Errorf("policy route %d: destination: %s", n.SeqNum, err)
+	}
+
+	switch n.Action {
+	case "", "permit", "deny":
+	default:
+		return nil, fmt.Errorf("policy route %d: invalid action %q", n.SeqNum, n.Action)
+	}
+
+	if n.Protocol < 0 || n.Protocol > 255 {
+		return nil, fmt.Errorf("policy route %d: protocol must be between 0 and 255, got %d", n.SeqNum, n.Protocol)
+	}
+
+	ports := [][2]int{{n.StartPort, n.EndPort}, {n.StartSourcePort, n.EndSourcePort}}
+	for _, r := range ports {
+		if r[0] < 0 || r[0] > 65535 || r[1] < 0 || r[1] > 65535 || (r[0] != 0 && r[1] != 0 && r[0] > r[1]) {
+			return nil, fmt.Errorf("policy route %d: invalid port range %d-%d", n.SeqNum, r[0], r[1])
+		}
+	}
+
+	if n.Gateway != "" {
+		if addr, err := netip.ParseAddr(n.Gateway); err != nil || !addr.Is4() {
+			return nil, fmt.Errorf("policy route %d: invalid gateway %q", n.SeqNum, n.Gateway)
+		}
+	}
+
+	return &n, nil
+}
+
+// routerPolicySubnets returns a copy of the subnets in the FortiOS notation
+func routerPolicySubnets(subnets []RouterPolicySubnet) ([]RouterPolicySubnet, error) {
+	if subnets == nil {
+		return nil, nil
+	}
+
+	out := make([]RouterPolicySubnet, 0, len(subnets))
+	for _, s := range subnets {
+		subnet, err := NormalizeSubnet(s.Subnet)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, RouterPolicySubnet{Subnet: subnet})
+	}
+	return out, nil
+}
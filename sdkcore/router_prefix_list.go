@@ -0,0 +1,434 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterPrefixList contains the parameters for Create and Update API function
+// The rules are evaluated in order, the first rule matching a route applies.
+type JSONRouterPrefixList struct {
+	Name     string                 `json:"name"`
+	Comments string                 `json:"comments"`
+	Rule     []RouterPrefixListRule `json:"rule"`
+}
+
+// RouterPrefixListRule contains a rule of a prefix list
+// Prefix accepts the "10.0.0.0/24" and the "10.0.0.0 255.255.255.0" notations, "any" matches every route.
+// Ge and Le match the longer prefixes up to the specified lengths, 0 is unset.
+type RouterPrefixListRule struct {
+	ID     int    `json:"id,omitempty"`
+	Action string `json:"action"`
+	Prefix string `json:"prefix"`
+	Ge     int    `json:"ge,omitempty"`
+	Le     int    `json:"le,omitempty"`
+}
+
+// JSONCreateRouterPrefixListOutput contains the output results for Create API function
+type JSONCreateRouterPrefixListOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateRouterPrefixListOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateRouterPrefixListOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateRouterPrefixList API operation for FortiOS creates a new prefix list for route filtering.
+// Returns the index value of the prefix list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - prefix-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateRouterPrefixList(params *JSONRouterPrefixList) (output *JSONCreateRouterPrefixListOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/prefix-list"
+	output = &JSONCreateRouterPrefixListOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateRouterPrefixList API operation for FortiOS updates the specified prefix list for route filtering.
+// Returns the index value of the prefix list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - prefix-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterPrefixList(params *JSONRouterPrefixList, mkey string) (output *JSONUpdateRouterPrefixListOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/prefix-list"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateRouterPrefixListOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteRouterPrefixList API operation for FortiOS deletes the specified prefix list for route filtering.
+// Returns error for service API and SDK errors.
+// See the router - prefix-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteRouterPrefixList(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/prefix-list"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterPrefixList API operation for FortiOS gets the prefix list for route filtering
+// with the specified index value.
+// Returns the requested prefix list value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - prefix-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterPrefixList(mkey string) (output *JSONRouterPrefixList, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/prefix-list"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONRouterPrefixList{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterPrefixList(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListRouterPrefixLists API operation for FortiOS gets all the prefix lists for route filtering.
+// Returns the prefix lists when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - prefix-list chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListRouterPrefixLists() (output []*JSONRouterPrefixList, err error) {
+	results, err := c.listCmdbTable("router/prefix-list")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterPrefixList, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONRouterPrefixList{}
+		fillRouterPrefixList(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillRouterPrefixList fills output from a prefix list of the response
+func fillRouterPrefixList(output *JSONRouterPrefixList, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+	if mapTmp["rule"] != nil {
+		member := mapTmp["rule"].([]interface{})
+
+		var members []RouterPrefixListRule
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := RouterPrefixListRule{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["action"] != nil {
+				m.Action = c["action"].(string)
+			}
+			if c["prefix"] != nil {
+				m.Prefix = c["prefix"].(string)
+			}
+			if c["ge"] != nil {
+				m.Ge = int(c["ge"].(float64))
+			}
+			if c["le"] != nil {
+				m.Le = int(c["le"].(float64))
+			}
+			members = append(members, m)
+		}
+		output.Rule = members
+	}
+}
+
+// normalize returns a copy of the prefix list with its prefixes in the FortiOS notation,
+// after checking the actions and the prefix lengths of the rules
+func (p *JSONRouterPrefixList) normalize() (*JSONRouterPrefixList, error) {
+	n := *p
+
+	n.Rule = make([]RouterPrefixListRule, len(p.Rule))
+	for i, r := range p.Rule {
+		switch r.Action {
+		case "", "permit", "deny":
+		default:
+			return nil, fmt.Errorf("prefix list %s: rule %d: invalid action %q", n.Name, r.ID, r.Action)
+		}
+
+		bits := 0
+		if r.Prefix != "any" {
+			prefix, err := ParseSubnet(r.Prefix)
+			if err != nil {
+				return nil, fmt.Errorf("prefix list %s: rule %d: %s", n.Name, r.ID, err)
+			}
+			if prefix.Masked() != prefix {
+				return nil, fmt.Errorf("prefix list %s: rule %d: prefix %q has host bits set", n.Name, r.ID, r.Prefix)
+			}
+			r.Prefix = FormatSubnet(prefix)
+			bits = prefix.Bits()
+		}
+
+		if (r.Ge != 0 && r.Ge < bits) || (r.Le != 0 && r.Le < bits) || (r.Ge != 0 && r.Le != 0 && r.Ge > r.Le) || r.Ge > 32 || r.Le > 32 {
+			return nil, fmt.Errorf("prefix list %s: rule %d: ge %d and le %d do not match prefix length %d", n.Name, r.ID, r.Ge, r.Le, bits)
+		}
+
+		n.Rule[i] = r
+	}
+
+	return &n, nil
+}
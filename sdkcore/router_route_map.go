@@ -0,0 +1,426 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONRouterRouteMap contains the parameters for Create and Update API function
+// The rules are evaluated in order, the first rule matching a route applies.
+type JSONRouterRouteMap struct {
+	Name     string               `json:"name"`
+	Comments string               `json:"comments"`
+	Rule     []RouterRouteMapRule `json:"rule"`
+}
+
+// RouterRouteMapRule contains a rule of a route map
+// MatchIPAddress and MatchIPNexthop are the names of an access list or a prefix list,
+// the Set attributes change the matching routes, 0 is unset.
+type RouterRouteMapRule struct {
+	ID                 int                    `json:"id,omitempty"`
+	Action             string                 `json:"action"`
+	MatchIPAddress     string                 `json:"match-ip-address"`
+	MatchIPNexthop     string                 `json:"match-ip-nexthop"`
+	MatchInterface     string                 `json:"match-interface"`
+	MatchMetric        int                    `json:"match-metric,omitempty"`
+	SetIPNexthop       string                 `json:"set-ip-nexthop,omitempty"`
+	SetLocalPreference int                    `json:"set-local-preference,omitempty"`
+	SetMetric          int                    `json:"set-metric,omitempty"`
+	SetWeight          int                    `json:"set-weight,omitempty"`
+	SetAspath          []RouterRouteMapAspath `json:"set-aspath,omitempty"`
+}
+
+// RouterRouteMapAspath contains an AS prepended to the AS path of the matching routes
+type RouterRouteMapAspath struct {
+	As string `json:"as"`
+}
+
+// JSONCreateRouterRouteMapOutput contains the output results for Create API function
+type JSONCreateRouterRouteMapOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateRouterRouteMapOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateRouterRouteMapOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateRouterRouteMap API operation for FortiOS creates a new route map for route filtering and attribute changes.
+// Returns the index value of the route map and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - route-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateRouterRouteMap(params *JSONRouterRouteMap) (output *JSONCreateRouterRouteMapOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/router/route-map"
+	output = &JSONCreateRouterRouteMapOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateRouterRouteMap API operation for FortiOS updates the specified route map for route filtering and attribute changes.
+// Returns the index value of the route map and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - route-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateRouterRouteMap(params *JSONRouterRouteMap, mkey string) (output *JSONUpdateRouterRouteMapOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/router/route-map"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateRouterRouteMapOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteRouterRouteMap API operation for FortiOS deletes the specified route map for route filtering and attribute changes.
+// Returns error for service API and SDK errors.
+// See the router - route-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteRouterRouteMap(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/router/route-map"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadRouterRouteMap API operation for FortiOS gets the route map for route filtering and attribute changes
+// with the specified index value.
+// Returns the requested route map value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - route-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadRouterRouteMap(mkey string) (output *JSONRouterRouteMap, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/router/route-map"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONRouterRouteMap{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillRouterRouteMap(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListRouterRouteMaps API operation for FortiOS gets all the route maps for route filtering and attribute changes.
+// Returns the route maps when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the router - route-map chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListRouterRouteMaps() (output []*JSONRouterRouteMap, err error) {
+	results, err := c.listCmdbTable("router/route-map")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONRouterRouteMap, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONRouterRouteMap{}
+		fillRouterRouteMap(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillRouterRouteMap fills output from a route map of the response
+func fillRouterRouteMap(output *JSONRouterRouteMap, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+	if mapTmp["rule"] != nil {
+		member := mapTmp["rule"].([]interface{})
+
+		var members []RouterRouteMapRule
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := RouterRouteMapRule{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["action"] != nil {
+				m.Action = c["action"].(string)
+			}
+			if c["match-ip-address"] != nil {
+				m.MatchIPAddress = c["match-ip-address"].(string)
+			}
+			if c["match-ip-nexthop"] != nil {
+				m.MatchIPNexthop = c["match-ip-nexthop"].(string)
+			}
+			if c["match-interface"] != nil {
+				m.MatchInterface = c["match-interface"].(string)
+			}
+			if c["match-metric"] != nil {
+				m.MatchMetric = int(c["match-metric"].(float64))
+			}
+			if c["set-ip-nexthop"] != nil {
+				m.SetIPNexthop = c["set-ip-nexthop"].(string)
+			}
+			if c["set-local-preference"] != nil {
+				m.SetLocalPreference = int(c["set-local-preference"].(float64))
+			}
+			if c["set-metric"] != nil {
+				m.SetMetric = int(c["set-metric"].(float64))
+			}
+			if c["set-weight"] != nil {
+				m.SetWeight = int(c["set-weight"].(float64))
+			}
+			if c["set-aspath"] != nil {
+				member := c["set-aspath"].([]interface{})
+
+				var members []RouterRouteMapAspath
+				for _, v := range member {
+					c := v.(map[string]interface{})
+					m := RouterRouteMapAspath{}
+					if c["as"] != nil {
+						m.As = c["as"].(string)
+					}
+					members = append(members, m)
+				}
+				m.SetAspath = members
+			}
+			members = append(members, m)
+		}
+		output.Rule = members
+	}
+}
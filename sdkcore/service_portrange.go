@@ -0,0 +1,216 @@
+package forticlient
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PortRange is a destination port range of a custom service, with an optional source port range.
+// SrcLow and SrcHigh are 0 when the range does not restrict the source ports.
+type PortRange struct {
+	Low     int
+	High    int
+	SrcLow  int
+	SrcHigh int
+}
+
+// PortRanges is the list of port ranges of a custom service protocol
+type PortRanges []PortRange
+
+// ParsePortRanges parses the FortiOS port range syntax, such as "80-90:1024-65535 443",
+// where the optional range after the colon is the source port range.
+// The ports must be within 1-65535, the low source port can be 0.
+// Returns error for an invalid range.
+func ParsePortRanges(spec string) (PortRanges, error) {
+	var out PortRanges
+
+	for _, f := range strings.Fields(spec) {
+		var r PortRange
+		var err error
+
+		dst, src, hasSrc := f, "", false
+		if i := strings.Index(f, ":"); i >= 0 {
+			dst, src, hasSrc = f[:i], f[i+1:], true
+		}
+
+		r.Low, r.High, err = parsePorts(dst, 1)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port range %q: %s", f, err)
+		}
+
+		if hasSrc {
+			r.SrcLow, r.SrcHigh, err = parsePorts(src, 0)
+			if err != nil {
+				return nil, fmt.Errorf("invalid source port range %q: %s", f, err)
+			}
+		}
+
+		out = append(out, r)
+	}
+
+	return out, nil
+}
+
+// parsePorts parses "low-high" or a single port, the bounds are swapped when low is above high
+func parsePorts(s string, min int) (low int, high int, err error) {
+	lo, hi := s, s
+	if i := strings.Index(s, "-"); i >= 0 {
+		lo, hi = s[:i], s[i+1:]
+	}
+
+	low, err = strconv.Atoi(lo)
+	if err != nil {
+		return
+	}
+	high, err = strconv.Atoi(hi)
+	if err != nil {
+		return
+	}
+	if low > high {
+		low, high = high, low
+	}
+
+	if low < min || high > 65535 {
+		err = fmt.Errorf("ports must be within %d-65535", min)
+	}
+
+	return
+}
+
+// AnySource reports whether the range accepts all the source ports
+func (r PortRange) AnySource() bool {
+	return (r.SrcLow == 0 && r.SrcHigh == 0) || (r.SrcLow <= 1 && r.SrcHigh == 65535)
+}
+
+// Contains reports whether the destination port is in the range
+func (r PortRange) Contains(port int) bool {
+	return port >= r.Low && port <= r.High
+}
+
+// String formats the range in the FortiOS syntax
+func (r PortRange) String() string {
+	s := formatPorts(r.Low, r.High)
+	if r.SrcLow != 0 || r.SrcHigh != 0 {
+		s += ":" + formatPorts(r.SrcLow, r.SrcHigh)
+	}
+	return s
+}
+
+// formatPorts formats "low-high", or the port when both are equal
+func formatPorts(low int, high int) string {
+	if low == high {
+		return strconv.Itoa(low)
+	}
+	return strconv.Itoa(low) + "-" + strconv.Itoa(high)
+}
+
+// String formats the ranges in the FortiOS syntax
+func (rs PortRanges) String() string {
+	parts := make([]string, 0, len(rs))
+	for _, r := range rs {
+		parts = append(parts, r.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+// Normalize returns the ranges sorted, with the source ranges accepting all the ports removed,
+// and the overlapping or adjacent ranges with the same source range merged
+func (rs PortRanges) Normalize() PortRanges {
+	out := make(PortRanges, 0, len(rs))
+	for _, r := range rs {
+		if r.AnySource() {
+			r.SrcLow, r.SrcHigh = 0, 0
+		}
+		out = append(out, r)
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.SrcLow != b.SrcLow {
+			return a.SrcLow < b.SrcLow
+		}
+		if a.SrcHigh != b.SrcHigh {
+			return a.SrcHigh < b.SrcHigh
+		}
+		if a.Low != b.Low {
+			return a.Low < b.Low
+		}
+		return a.High < b.High
+	})
+
+	merged := out[:0]
+	for _, r := range out {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			if last.SrcLow == r.SrcLow && last.SrcHigh == r.SrcHigh && r.Low <= last.High+1 {
+				if r.High > last.High {
+					last.High = r.High
+				}
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Low < merged[j].Low
+	})
+
+	return merged
+}
+
+// Equal reports whether rs and o contain the same ports once normalized
+func (rs PortRanges) Equal(o PortRanges) bool {
+	return rs.Normalize().String() == o.Normalize().String()
+}
+
+// NormalizePortRange converts a port range in the FortiOS syntax to its normalized form.
+// Returns error for an invalid range.
+func NormalizePortRange(spec string) (string, error) {
+	rs, err := ParsePortRanges(spec)
+	if err != nil {
+		return "", err
+	}
+	return rs.Normalize().String(), nil
+}
+
+// TCPPortRanges returns the TCP port ranges of the service
+func (s *JSONFirewallObjectServiceCommon) TCPPortRanges() (PortRanges, error) {
+	return ParsePortRanges(s.TCPPortrange)
+}
+
+// UDPPortRanges returns the UDP port ranges of the service
+func (s *JSONFirewallObjectServiceCommon) UDPPortRanges() (PortRanges, error) {
+	return ParsePortRanges(s.UDPPortrange)
+}
+
+// SctpPortRanges returns the SCTP port ranges of the service
+func (s *JSONFirewallObjectServiceCommon) SctpPortRanges() (PortRanges, error) {
+	return ParsePortRanges(s.SctpPortrange)
+}
+
+// normalize returns a copy of the service with the port ranges normalized.
+// Returns error for an invalid port range.
+func (s *JSONFirewallObjectService) normalize() (*JSONFirewallObjectService, error) {
+	n := *s
+	if s.JSONFirewallObjectServiceCommon == nil {
+		return &n, nil
+	}
+
+	common := *s.JSONFirewallObjectServiceCommon
+	for _, p := range []*string{&common.TCPPortrange, &common.UDPPortrange, &common.SctpPortrange} {
+		if *p == "" {
+			continue
+		}
+		v, err := NormalizePortRange(*p)
+		if err != nil {
+			return nil, err
+		}
+		*p = v
+	}
+	n.JSONFirewallObjectServiceCommon = &common
+
+	return &n, nil
+}
@@ -0,0 +1,88 @@
+package forticlient
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePortRanges(t *testing.T) {
+	tests := []struct {
+		spec string
+		want PortRanges
+	}{
+		{"", nil},
+		{"443", PortRanges{{Low: 443, High: 443}}},
+		{"80-90", PortRanges{{Low: 80, High: 90}}},
+		{"90-80", PortRanges{{Low: 80, High: 90}}},
+		{"80-90:1024-65535 443", PortRanges{{Low: 80, High: 90, SrcLow: 1024, SrcHigh: 65535}, {Low: 443, High: 443}}},
+		{"53:0-65535", PortRanges{{Low: 53, High: 53, SrcLow: 0, SrcHigh: 65535}}},
+		{"  1-65535  ", PortRanges{{Low: 1, High: 65535}}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePortRanges(tt.spec)
+		if err != nil {
+			t.Errorf("ParsePortRanges(%q) error = %v", tt.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePortRanges(%q) = %v, want %v", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestParsePortRangesErrors(t *testing.T) {
+	for _, spec := range []string{"0", "65536", "80-", "-80", "http", "80-90-100", "80:", "80:x", "80:70000"} {
+		if got, err := ParsePortRanges(spec); err == nil {
+			t.Errorf("ParsePortRanges(%q) = %v, want error", spec, got)
+		}
+	}
+}
+
+func TestNormalizePortRange(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"443 80", "80 443"},
+		{"80-90 85-100", "80-100"},
+		{"80-90 91-100", "80-100"},
+		{"80-90 92-100", "80-90 92-100"},
+		{"80:1-65535", "80"},
+		{"80:0-65535 81", "80-81"},
+		{"80:1024-2048 81", "80:1024-2048 81"},
+		{"100-200:1000 150-300:1000", "100-300:1000"},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizePortRange(tt.spec)
+		if err != nil {
+			t.Errorf("NormalizePortRange(%q) error = %v", tt.spec, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("NormalizePortRange(%q) = %q, want %q", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestPortRangesEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"80 443", "443 80", true},
+		{"80-85", "80 81 82 83 84 85", true},
+		{"80:1-65535", "80", true},
+		{"80:1024-2048", "80", false},
+		{"80-90", "80-91", false},
+	}
+
+	for _, tt := range tests {
+		a, _ := ParsePortRanges(tt.a)
+		b, _ := ParsePortRanges(tt.b)
+		if got := a.Equal(b); got != tt.want {
+			t.Errorf("%q Equal %q = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
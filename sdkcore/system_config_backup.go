@@ -0,0 +1,252 @@
+package forticlient
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"time"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// restoreRebootDelay is the time given to FortiOS to start rebooting after a restore
+// before the SDK starts polling the device again
+const restoreRebootDelay = 30 * time.Second
+
+// restorePollInterval is the interval between two polls while waiting for the device
+const restorePollInterval = 10 * time.Second
+
+// JSONSystemConfigBackup contains the parameters for Backup API function
+// Scope is "global" or "vdom", Vdom is only used with the "vdom" scope,
+// Password encrypts the backup file when it is not empty, it is sent in the request body
+type JSONSystemConfigBackup struct {
+	Scope    string `json:"scope"`
+	Vdom     string `json:"-"`
+	Password string `json:"password,omitempty"`
+}
+
+// JSONSystemConfigRestore contains the parameters for Restore API function
+// FileContent is the base64 encoded configuration file, it is filled by RestoreConfig
+type JSONSystemConfigRestore struct {
+	Source      string `json:"source"`
+	Scope       string `json:"scope"`
+	Vdom        string `json:"vdom,omitempty"`
+	Password    string `json:"password,omitempty"`
+	FileContent string `json:"file_content"`
+}
+
+// JSONSystemConfigRestoreOutput contains the output results for Restore API function
+type JSONSystemConfigRestoreOutput struct {
+	Vdom       string  `json:"vdom"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// BackupConfig API operation for FortiOS backs up the system configuration
+// and streams the configuration file to w.
+// Returns the number of bytes written when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the execute - backup chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) BackupConfig(params *JSONSystemConfigBackup, w io.Writer) (written int64, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/monitor/system/config/backup"
+
+	if params.Scope != "global" && params.Scope != "vdom" {
+		err = fmt.Errorf("scope must be global or vdom, got %q", params.Scope)
+		return
+	}
+
+	// the password goes in the body, the URL is logged when the request is resent
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	req := c.NewRequest(HTTPMethod, path, nil, bytes.NewBuffer(locJSON))
+	if params.Scope == "vdom" && params.Vdom != "" {
+		req.FillUrlParam("vdom", params.Vdom)
+	}
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	defer req.HTTPResponse.Body.Close()
+
+	if req.HTTPResponse.StatusCode != 200 {
+		body, _ := ioutil.ReadAll(req.HTTPResponse.Body)
+		log.Printf("FOS-fortios response: %s", string(body))
+
+		var result map[string]interface{}
+		json.Unmarshal([]byte(string(body)), &result)
+
+		if result != nil && result["error"] != nil {
+			err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+		} else {
+			err = fmt.Errorf("status is error and error no is not found")
+		}
+		err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(req.HTTPResponse.StatusCode))
+
+		return
+	}
+
+	written, err = io.Copy(w, req.HTTPResponse.Body)
+	if err != nil {
+		err = fmt.Errorf("cannot read config file from the response %s", err)
+		return
+	}
+
+	return
+}
+
+// RestoreConfig API operation for FortiOS uploads the configuration file read from r
+// and restores it. FortiOS reboots after a successful restore, RestoreConfig then waits
+// up to timeout for the device to come back.
+// Returns the execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the execute - restore chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) RestoreConfig(params *JSONSystemConfigRestore, r io.Reader, timeout time.Duration) (output *JSONSystemConfigRestoreOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/monitor/system/config/restore"
+	output = &JSONSystemConfigRestoreOutput{}
+
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		err = fmt.Errorf("cannot read config file %s", err)
+		return
+	}
+
+	// the caller's params are left untouched
+	upload := *params
+	upload.Source = "upload"
+	upload.FileContent = base64.StdEncoding.EncodeToString(content)
+
+	locJSON, err := json.Marshal(&upload)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	err = c.WaitForDevice(restoreRebootDelay, timeout)
+
+	return
+}
+
+// WaitForDevice waits delay, then polls FortiOS until it answers the API again
+// or timeout expires. The delay is cut short at the deadline, and each poll
+// is a single request canceled at the deadline.
+// Returns error when the device is not back in time.
+func (c *FortiSDKClient) WaitForDevice(delay time.Duration, timeout time.Duration) (err error) {
+	deadline := time.Now().Add(timeout)
+
+	if wait := time.Until(deadline); delay > wait {
+		delay = wait
+	}
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+
+	for {
+		if time.Now().Before(deadline) && c.isDeviceReady(deadline) {
+			return
+		}
+
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			err = fmt.Errorf("device is not back after %s", timeout)
+			return
+		}
+		if wait > restorePollInterval {
+			wait = restorePollInterval
+		}
+
+		log.Printf("FOS-fortios waiting for device %s", c.Config.FwTarget)
+		time.Sleep(wait)
+	}
+}
+
+// isDeviceReady checks whether FortiOS answers the API before deadline
+func (c *FortiSDKClient) isDeviceReady(deadline time.Time) bool {
+	HTTPMethod := "GET"
+	path := "/api/v2/monitor/system/status"
+
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err := req.SendOnce(ctx)
+	if err != nil || req.HTTPResponse == nil {
+		return false
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	req.HTTPResponse.Body.Close()
+	if err != nil || body == nil {
+		return false
+	}
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	return result != nil && result["status"] == "success"
+}
@@ -0,0 +1,54 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+)
+
+// newConfigTestClient returns a client of a fake device answering every request with status
+func newConfigTestClient(t *testing.T, status string) *FortiSDKClient {
+	device := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"status": status, "http_status": 200})
+	}))
+	t.Cleanup(device.Close)
+
+	u, err := url.Parse(device.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client())
+}
+
+func TestWaitForDeviceDelayCappedByTimeout(t *testing.T) {
+	c := newConfigTestClient(t, "error")
+
+	start := time.Now()
+	err := c.WaitForDevice(30*time.Second, 200*time.Millisecond)
+	if err == nil {
+		t.Error("WaitForDevice() error = nil, want timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("WaitForDevice() returned after %s, want about the 200ms timeout", elapsed)
+	}
+}
+
+func TestRestoreConfigKeepsParams(t *testing.T) {
+	// the device rejects the upload, RestoreConfig returns before waiting for a reboot
+	c := newConfigTestClient(t, "error")
+
+	params := &JSONSystemConfigRestore{Scope: "global"}
+	_, err := c.RestoreConfig(params, strings.NewReader("config system global\nend\n"), time.Second)
+	if err == nil {
+		t.Error("RestoreConfig() error = nil, want the device error")
+	}
+	if params.Source != "" || params.FileContent != "" {
+		t.Errorf("RestoreConfig() changed the params to %+v", params)
+	}
+}
@@ -0,0 +1,436 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONSystemExternalResource contains the parameters for Create and Update API function
+// Type is "address", "domain", "category" or "malware", Category is the web filter category
+// of a category feed, from 192 to 221. RefreshRate is in minutes.
+// Password is write only, Update keeps the current password when it is empty.
+type JSONSystemExternalResource struct {
+	Name                  string `json:"name"`
+	Status                string `json:"status"`
+	Type                  string `json:"type"`
+	Category              int    `json:"category,omitempty"`
+	Comments              string `json:"comments"`
+	Resource              string `json:"resource"`
+	RefreshRate           int    `json:"refresh-rate,omitempty"`
+	Username              string `json:"username"`
+	Password              string `json:"password,omitempty"`
+	SourceIP              string `json:"source-ip,omitempty"`
+	InterfaceSelectMethod string `json:"interface-select-method,omitempty"`
+	Interface             string `json:"interface,omitempty"`
+	UserAgent             string `json:"user-agent,omitempty"`
+}
+
+// JSONCreateSystemExternalResourceOutput contains the output results for Create API function
+type JSONCreateSystemExternalResourceOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateSystemExternalResourceOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateSystemExternalResourceOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateSystemExternalResource API operation for FortiOS creates a new external resource, a threat feed downloaded periodically by FortiOS.
+// Returns the index value of the external resource and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the system - external-resource chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateSystemExternalResource(params *JSONSystemExternalResource) (output *JSONCreateSystemExternalResourceOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/system/external-resource"
+	output = &JSONCreateSystemExternalResourceOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateSystemExternalResource API operation for FortiOS updates the specified external resource, a threat feed downloaded periodically by FortiOS.
+// Returns the index value of the external resource and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the system - external-resource chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateSystemExternalResource(params *JSONSystemExternalResource, mkey string) (output *JSONUpdateSystemExternalResourceOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/system/external-resource"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateSystemExternalResourceOutput{}
+
+	params, err = params.normalize()
+	if err != nil {
+		return
+	}
+
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteSystemExternalResource API operation for FortiOS deletes the specified external resource, a threat feed downloaded periodically by FortiOS.
+// Returns error for service API and SDK errors.
+// See the system - external-resource chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteSystemExternalResource(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/system/external-resource"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadSystemExternalResource API operation for FortiOS gets the external resource, a threat feed downloaded periodically by FortiOS
+// with the specified index value.
+// Returns the requested external resource value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the system - external-resource chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadSystemExternalResource(mkey string) (output *JSONSystemExternalResource, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/system/external-resource"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONSystemExternalResource{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillSystemExternalResource(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListSystemExternalResources API operation for FortiOS gets all the external resources, the threat feeds downloaded periodically by FortiOS.
+// Returns the external resources when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the system - external-resource chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListSystemExternalResources() (output []*JSONSystemExternalResource, err error) {
+	results, err := c.listCmdbTable("system/external-resource")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONSystemExternalResource, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONSystemExternalResource{}
+		fillSystemExternalResource(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillSystemExternalResource fills output from a external resource of the response
+func fillSystemExternalResource(output *JSONSystemExternalResource, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["category"] != nil {
+		output.Category = int(mapTmp["category"].(float64))
+	}
+	if mapTmp["comments"] != nil {
+		output.Comments = mapTmp["comments"].(string)
+	}
+	if mapTmp["resource"] != nil {
+		output.Resource = mapTmp["resource"].(string)
+	}
+	if mapTmp["refresh-rate"] != nil {
+		output.RefreshRate = int(mapTmp["refresh-rate"].(float64))
+	}
+	if mapTmp["username"] != nil {
+		output.Username = mapTmp["username"].(string)
+	}
+	// FortiOS never returns the password, it is write only
+	if mapTmp["source-ip"] != nil {
+		output.SourceIP = mapTmp["source-ip"].(string)
+	}
+	if mapTmp["interface-select-method"] != nil {
+		output.InterfaceSelectMethod = mapTmp["interface-select-method"].(string)
+	}
+	if mapTmp["interface"] != nil {
+		output.Interface = mapTmp["interface"].(string)
+	}
+	if mapTmp["user-agent"] != nil {
+		output.UserAgent = mapTmp["user-agent"].(string)
+	}
+}
+
+// normalize returns a copy of the external resource after checking the type,
+// the category and the resource URL, which FortiOS only reports when downloading it
+func (r *JSONSystemExternalResource) normalize() (*JSONSystemExternalResource, error) {
+	n := *r
+
+	switch n.Type {
+	case "", "address", "domain", "malware":
+		if n.Category != 0 {
+			return nil, fmt.Errorf("external resource %s: category is only valid for the category type", n.Name)
+		}
+	case "category":
+		if n.Category < 192 || n.Category > 221 {
+			return nil, fmt.Errorf("external resource %s: category must be between 192 and 221, got %d", n.Name, n.Category)
+		}
+	default:
+		return nil, fmt.Errorf("external resource %s: invalid type %q", n.Name, n.Type)
+	}
+
+	if n.Resource != "" {
+		u, err := url.Parse(n.Resource)
+		if err != nil {
+			return nil, fmt.Errorf("external resource %s: invalid resource %q: %s", n.Name, n.Resource, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("external resource %s: resource must be an http or https URL, got %q", n.Name, n.Resource)
+		}
+	}
+
+	return &n, nil
+}
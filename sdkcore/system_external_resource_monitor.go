@@ -0,0 +1,144 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// JSONSystemExternalResourceStatus contains the download status of an external resource
+// ResourceFileStatus is the FortiOS status of the last download, such as "ok" or an error
+// message, LastUpdate is zero when the resource has never been downloaded.
+type JSONSystemExternalResourceStatus struct {
+	Name               string
+	ResourceFileStatus string
+	LastUpdate         time.Time
+	TotalEntries       int
+	ValidEntries       int
+	InvalidEntries     int
+}
+
+// JSONSystemExternalResourceEntries contains the entries of the last download of an external resource
+type JSONSystemExternalResourceEntries struct {
+	JSONSystemExternalResourceStatus
+	Valid   []string
+	Invalid []string
+}
+
+// ReadSystemExternalResourceStatus API operation for FortiOS gets the status and the number of
+// entries of the last download of the external resource with the specified name.
+// Returns the status when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the diagnose sys external-resource chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadSystemExternalResourceStatus(mkey string) (output *JSONSystemExternalResourceStatus, err error) {
+	entries, err := c.readSystemExternalResourceEntryList(mkey, true)
+	if err != nil {
+		return
+	}
+
+	output = &entries.JSONSystemExternalResourceStatus
+	return
+}
+
+// ReadSystemExternalResourceEntries API operation for FortiOS gets the valid and the invalid
+// entries of the last download of the external resource with the specified name.
+// The empty lines and the comments of the resource file are not returned.
+// Returns the entries when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the diagnose sys external-resource chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadSystemExternalResourceEntries(mkey string) (output *JSONSystemExternalResourceEntries, err error) {
+	return c.readSystemExternalResourceEntryList(mkey, false)
+}
+
+// readSystemExternalResourceEntryList gets the entry list of an external resource,
+// statusOnly skips the entries and only returns the status and the counts
+func (c *FortiSDKClient) readSystemExternalResourceEntryList(mkey string, statusOnly bool) (output *JSONSystemExternalResourceEntries, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/monitor/system/external-resource/entry-list"
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	req.FillUrlParam("mkey", mkey)
+	if statusOnly {
+		req.FillUrlParam("status_only", "true")
+	}
+
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	mapTmp, ok := result["results"].(map[string]interface{})
+	if !ok {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	output = &JSONSystemExternalResourceEntries{}
+	output.Name = mkey
+	if mapTmp["resource_file_status"] != nil {
+		output.ResourceFileStatus = fmt.Sprint(mapTmp["resource_file_status"])
+	}
+	output.LastUpdate = unixTime(mapTmp["last_content_update_time"])
+
+	if mapTmp["valid_entries"] != nil {
+		output.Valid = entryStrings(mapTmp["valid_entries"])
+	}
+	if mapTmp["invalid_entries"] != nil {
+		output.Invalid = entryStrings(mapTmp["invalid_entries"])
+	}
+
+	output.ValidEntries = entryCount(mapTmp["valid_count"], len(output.Valid))
+	output.InvalidEntries = entryCount(mapTmp["invalid_count"], len(output.Invalid))
+	output.TotalEntries = entryCount(mapTmp["total_count"], output.ValidEntries+output.InvalidEntries)
+
+	return
+}
+
+// entryStrings converts a list of entries of the response
+func entryStrings(v interface{}) []string {
+	list, _ := v.([]interface{})
+
+	entries := make([]string, 0, len(list))
+	for _, e := range list {
+		if m, ok := e.(map[string]interface{}); ok {
+			e = m["entry"]
+		}
+		if e != nil {
+			entries = append(entries, fmt.Sprint(e))
+		}
+	}
+	return entries
+}
+
+// entryCount returns the count of the response, or def when the response has no count
+func entryCount(v interface{}, def int) int {
+	f, ok := v.(float64)
+	if !ok {
+		return def
+	}
+	return int(f)
+}
+
+// RefreshSystemExternalResource API operation for FortiOS downloads now the external resource
+// with the specified name, without waiting for its refresh rate.
+// The download is asynchronous, use ReadSystemExternalResourceStatus to get its result.
+// Returns error for service API and SDK errors.
+// See the execute update-external-resource chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) RefreshSystemExternalResource(mkey string) (err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/monitor/system/external-resource/refresh"
+
+	locJSON, err := json.Marshal(map[string]interface{}{
+		"mkey":              mkey,
+		"check_status_only": false,
+	})
+	if err != nil {
+		return
+	}
+
+	req := c.NewRequest(HTTPMethod, path, nil, bytes.NewBuffer(locJSON))
+	_, err = c.sendRequest(req)
+
+	return
+}
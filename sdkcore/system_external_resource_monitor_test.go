@@ -0,0 +1,259 @@
+package forticlient
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+)
+
+// fakeExternalResourceDevice emulates the external resource API of FortiOS,
+// a refresh downloads the resource from its URL the way the device does
+type fakeExternalResourceDevice struct {
+	mu        sync.Mutex
+	resources map[string]string
+	valid     map[string][]string
+	invalid   map[string][]string
+	updated   map[string]int64
+}
+
+func newFakeExternalResourceDevice() *fakeExternalResourceDevice {
+	return &fakeExternalResourceDevice{
+		resources: make(map[string]string),
+		valid:     make(map[string][]string),
+		invalid:   make(map[string][]string),
+		updated:   make(map[string]int64),
+	}
+}
+
+func (d *fakeExternalResourceDevice) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	reply := func(v map[string]interface{}) {
+		if v["status"] == nil {
+			v["status"] = "success"
+			v["http_status"] = 200
+		}
+		json.NewEncoder(w).Encode(v)
+	}
+	notFound := map[string]interface{}{"status": "error", "http_status": 404, "error": -3}
+
+	switch {
+	case r.Method == "POST" && r.URL.Path == "/api/v2/cmdb/system/external-resource":
+		var body JSONSystemExternalResource
+		json.NewDecoder(r.Body).Decode(&body)
+		d.resources[body.Name] = body.Resource
+		reply(map[string]interface{}{"mkey": body.Name, "vdom": "root"})
+
+	case r.Method == "POST" && r.URL.Path == "/api/v2/monitor/system/external-resource/refresh":
+		var body struct {
+			Mkey string `json:"mkey"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		resource, ok := d.resources[body.Mkey]
+		if !ok {
+			reply(notFound)
+			return
+		}
+		if err := d.download(body.Mkey, resource); err != nil {
+			reply(map[string]interface{}{"status": "error", "http_status": 500, "error": -1})
+			return
+		}
+		reply(map[string]interface{}{})
+
+	case r.Method == "GET" && r.URL.Path == "/api/v2/monitor/system/external-resource/entry-list":
+		name := r.URL.Query().Get("mkey")
+		if _, ok := d.resources[name]; !ok {
+			reply(notFound)
+			return
+		}
+
+		results := map[string]interface{}{
+			"valid_count":   len(d.valid[name]),
+			"invalid_count": len(d.invalid[name]),
+			"total_count":   len(d.valid[name]) + len(d.invalid[name]),
+		}
+		if d.updated[name] != 0 {
+			results["resource_file_status"] = "ok"
+			results["last_content_update_time"] = d.updated[name]
+		} else {
+			results["resource_file_status"] = "no download"
+		}
+		if r.URL.Query().Get("status_only") != "true" {
+			results["valid_entries"] = entryObjects(d.valid[name])
+			results["invalid_entries"] = entryObjects(d.invalid[name])
+		}
+		reply(map[string]interface{}{"results": results})
+
+	default:
+		reply(notFound)
+	}
+}
+
+// download reads the address feed, skipping the empty lines and the comments
+func (d *fakeExternalResourceDevice) download(name string, resource string) error {
+	rsp, err := http.Get(resource)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	valid, invalid := []string{}, []string{}
+	s := bufio.NewScanner(rsp.Body)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if _, err := netip.ParsePrefix(line); err == nil {
+			valid = append(valid, line)
+		} else if _, err := netip.ParseAddr(line); err == nil {
+			valid = append(valid, line)
+		} else {
+			invalid = append(invalid, line)
+		}
+	}
+
+	d.valid[name], d.invalid[name] = valid, invalid
+	d.updated[name] = time.Now().Unix()
+	return s.Err()
+}
+
+func entryObjects(entries []string) []interface{} {
+	out := []interface{}{}
+	for _, e := range entries {
+		out = append(out, map[string]interface{}{"entry": e})
+	}
+	return out
+}
+
+// newExternalResourceTestClient starts a feed server serving *feed and a fake device,
+// and returns a client of the device
+func newExternalResourceTestClient(t *testing.T, feed *string, mu *sync.Mutex) (c *FortiSDKClient, feedURL string) {
+	feedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Write([]byte(*feed))
+	}))
+	t.Cleanup(feedServer.Close)
+
+	device := httptest.NewTLSServer(newFakeExternalResourceDevice())
+	t.Cleanup(device.Close)
+
+	u, err := url.Parse(device.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c = NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client())
+	return c, feedServer.URL + "/blocklist.txt"
+}
+
+func TestSystemExternalResourceRefresh(t *testing.T) {
+	var mu sync.Mutex
+	feed := "# blocked hosts\n192.0.2.1\n198.51.100.0/24\n\nnot-an-address\n2001:db8::1\n"
+
+	c, feedURL := newExternalResourceTestClient(t, &feed, &mu)
+
+	_, err := c.CreateSystemExternalResource(&JSONSystemExternalResource{
+		Name:        "blocklist",
+		Status:      "enable",
+		Type:        "address",
+		Resource:    feedURL,
+		RefreshRate: 5,
+	})
+	if err != nil {
+		t.Fatalf("CreateSystemExternalResource() error = %v", err)
+	}
+
+	status, err := c.ReadSystemExternalResourceStatus("blocklist")
+	if err != nil {
+		t.Fatalf("ReadSystemExternalResourceStatus() error = %v", err)
+	}
+	if !status.LastUpdate.IsZero() || status.TotalEntries != 0 {
+		t.Errorf("status before refresh = %+v, want no download", status)
+	}
+
+	if err := c.RefreshSystemExternalResource("blocklist"); err != nil {
+		t.Fatalf("RefreshSystemExternalResource() error = %v", err)
+	}
+
+	status, err = c.ReadSystemExternalResourceStatus("blocklist")
+	if err != nil {
+		t.Fatalf("ReadSystemExternalResourceStatus() error = %v", err)
+	}
+	if status.Name != "blocklist" || status.ResourceFileStatus != "ok" || status.LastUpdate.IsZero() {
+		t.Errorf("status after refresh = %+v, want a successful download", status)
+	}
+	if status.TotalEntries != 4 || status.ValidEntries != 3 || status.InvalidEntries != 1 {
+		t.Errorf("counts = %d total, %d valid, %d invalid, want 4, 3, 1", status.TotalEntries, status.ValidEntries, status.InvalidEntries)
+	}
+
+	entries, err := c.ReadSystemExternalResourceEntries("blocklist")
+	if err != nil {
+		t.Fatalf("ReadSystemExternalResourceEntries() error = %v", err)
+	}
+	if want := []string{"192.0.2.1", "198.51.100.0/24", "2001:db8::1"}; !reflect.DeepEqual(entries.Valid, want) {
+		t.Errorf("valid entries = %v, want %v", entries.Valid, want)
+	}
+	if want := []string{"not-an-address"}; !reflect.DeepEqual(entries.Invalid, want) {
+		t.Errorf("invalid entries = %v, want %v", entries.Invalid, want)
+	}
+
+	// a refresh downloads the new content of the feed
+	mu.Lock()
+	feed = "203.0.113.7\n"
+	mu.Unlock()
+
+	if err := c.RefreshSystemExternalResource("blocklist"); err != nil {
+		t.Fatalf("RefreshSystemExternalResource() error = %v", err)
+	}
+
+	entries, err = c.ReadSystemExternalResourceEntries("blocklist")
+	if err != nil {
+		t.Fatalf("ReadSystemExternalResourceEntries() error = %v", err)
+	}
+	if entries.TotalEntries != 1 || !reflect.DeepEqual(entries.Valid, []string{"203.0.113.7"}) || len(entries.Invalid) != 0 {
+		t.Errorf("entries after the feed changed = %+v, want 203.0.113.7 only", entries)
+	}
+}
+
+func TestSystemExternalResourceUnknown(t *testing.T) {
+	var mu sync.Mutex
+	feed := ""
+
+	c, _ := newExternalResourceTestClient(t, &feed, &mu)
+
+	if err := c.RefreshSystemExternalResource("missing"); err == nil {
+		t.Error("RefreshSystemExternalResource() error = nil, want error for an unknown resource")
+	}
+	if _, err := c.ReadSystemExternalResourceStatus("missing"); err == nil {
+		t.Error("ReadSystemExternalResourceStatus() error = nil, want error for an unknown resource")
+	}
+}
+
+func TestSystemExternalResourceInvalidURL(t *testing.T) {
+	var mu sync.Mutex
+	feed := ""
+
+	c, _ := newExternalResourceTestClient(t, &feed, &mu)
+
+	_, err := c.CreateSystemExternalResource(&JSONSystemExternalResource{
+		Name:     "blocklist",
+		Type:     "address",
+		Resource: "ftp://192.0.2.10/blocklist.txt",
+	})
+	if err == nil {
+		t.Error("CreateSystemExternalResource() error = nil, want error for a non http resource")
+	}
+}
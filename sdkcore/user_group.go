@@ -0,0 +1,404 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONUserGroup contains the parameters for Create and Update API function
+// GroupType is "firewall", "fsso-service", "rsso" or "guest". Member lists the local users,
+// the remote servers and the FSSO groups, Match restricts the remote members to their server groups.
+type JSONUserGroup struct {
+	Name              string           `json:"name"`
+	ID                int              `json:"id,omitempty"`
+	GroupType         string           `json:"group-type"`
+	Authtimeout       int              `json:"authtimeout"`
+	Member            MultValues       `json:"member"`
+	Match             []UserGroupMatch `json:"match"`
+	SsoAttributeValue string           `json:"sso-attribute-value,omitempty"`
+}
+
+// UserGroupMatch contains a group of a remote authentication server member of a user group
+type UserGroupMatch struct {
+	ID         int    `json:"id,omitempty"`
+	ServerName string `json:"server-name"`
+	GroupName  string `json:"group-name"`
+}
+
+// JSONCreateUserGroupOutput contains the output results for Create API function
+type JSONCreateUserGroupOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateUserGroupOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateUserGroupOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateUserGroup API operation for FortiOS creates a new user group for firewall policies.
+// Returns the index value of the user group and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - group chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateUserGroup(params *JSONUserGroup) (output *JSONCreateUserGroupOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/user/group"
+	output = &JSONCreateUserGroupOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateUserGroup API operation for FortiOS updates the specified user group for firewall policies.
+// Returns the index value of the user group and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - group chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateUserGroup(params *JSONUserGroup, mkey string) (output *JSONUpdateUserGroupOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/user/group"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateUserGroupOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteUserGroup API operation for FortiOS deletes the specified user group for firewall policies.
+// Returns error for service API and SDK errors.
+// See the user - group chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteUserGroup(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/user/group"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadUserGroup API operation for FortiOS gets the user group for firewall policies
+// with the specified index value.
+// Returns the requested user group value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - group chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadUserGroup(mkey string) (output *JSONUserGroup, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/user/group"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONUserGroup{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillUserGroup(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListUserGroups API operation for FortiOS gets all the user groups for firewall policies.
+// Returns the user groups when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - group chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListUserGroups() (output []*JSONUserGroup, err error) {
+	results, err := c.listCmdbTable("user/group")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONUserGroup, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONUserGroup{}
+		fillUserGroup(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillUserGroup fills output from a user group of the response
+func fillUserGroup(output *JSONUserGroup, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["id"] != nil {
+		output.ID = int(mapTmp["id"].(float64))
+	}
+	if mapTmp["group-type"] != nil {
+		output.GroupType = mapTmp["group-type"].(string)
+	}
+	if mapTmp["authtimeout"] != nil {
+		output.Authtimeout = int(mapTmp["authtimeout"].(float64))
+	}
+	if mapTmp["member"] != nil {
+		member := mapTmp["member"].([]interface{})
+
+		var members []MultValue
+		for _, v := range member {
+			c := v.(map[string]interface{})
+
+			members = append(members,
+				MultValue{
+					Name: c["name"].(string),
+				})
+		}
+		output.Member = members
+	}
+	if mapTmp["match"] != nil {
+		member := mapTmp["match"].([]interface{})
+
+		var members []UserGroupMatch
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := UserGroupMatch{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["server-name"] != nil {
+				m.ServerName = c["server-name"].(string)
+			}
+			if c["group-name"] != nil {
+				m.GroupName = c["group-name"].(string)
+			}
+			members = append(members, m)
+		}
+		output.Match = members
+	}
+	if mapTmp["sso-attribute-value"] != nil {
+		output.SsoAttributeValue = mapTmp["sso-attribute-value"].(string)
+	}
+}
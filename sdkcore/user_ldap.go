@@ -0,0 +1,403 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONUserLdap contains the parameters for Create and Update API function
+// Type is "simple", "anonymous" or "regular", Username and Password bind a regular server.
+// Secure is "disable", "starttls" or "ldaps". Password is write only, Update keeps the current
+// password when it is empty.
+type JSONUserLdap struct {
+	Name                  string `json:"name"`
+	Server                string `json:"server"`
+	SecondaryServer       string `json:"secondary-server"`
+	TertiaryServer        string `json:"tertiary-server"`
+	Port                  int    `json:"port"`
+	SourceIP              string `json:"source-ip"`
+	Cnid                  string `json:"cnid"`
+	Dn                    string `json:"dn"`
+	Type                  string `json:"type"`
+	Username              string `json:"username"`
+	Password              string `json:"password,omitempty"`
+	GroupMemberCheck      string `json:"group-member-check"`
+	Secure                string `json:"secure"`
+	CaCert                string `json:"ca-cert"`
+	PasswordExpiryWarning string `json:"password-expiry-warning,omitempty"`
+	PasswordRenewal       string `json:"password-renewal,omitempty"`
+}
+
+// JSONCreateUserLdapOutput contains the output results for Create API function
+type JSONCreateUserLdapOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateUserLdapOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateUserLdapOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateUserLdap API operation for FortiOS creates a new LDAP server for user authentication.
+// Returns the index value of the LDAP server and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - ldap chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateUserLdap(params *JSONUserLdap) (output *JSONCreateUserLdapOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/user/ldap"
+	output = &JSONCreateUserLdapOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateUserLdap API operation for FortiOS updates the specified LDAP server for user authentication.
+// Returns the index value of the LDAP server and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - ldap chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateUserLdap(params *JSONUserLdap, mkey string) (output *JSONUpdateUserLdapOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/user/ldap"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateUserLdapOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteUserLdap API operation for FortiOS deletes the specified LDAP server for user authentication.
+// Returns error for service API and SDK errors.
+// See the user - ldap chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteUserLdap(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/user/ldap"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadUserLdap API operation for FortiOS gets the LDAP server for user authentication
+// with the specified index value.
+// Returns the requested LDAP server value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - ldap chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadUserLdap(mkey string) (output *JSONUserLdap, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/user/ldap"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONUserLdap{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillUserLdap(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListUserLdaps API operation for FortiOS gets all the LDAP servers for user authentication.
+// Returns the LDAP servers when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - ldap chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListUserLdaps() (output []*JSONUserLdap, err error) {
+	results, err := c.listCmdbTable("user/ldap")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONUserLdap, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONUserLdap{}
+		fillUserLdap(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillUserLdap fills output from a LDAP server of the response
+func fillUserLdap(output *JSONUserLdap, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["server"] != nil {
+		output.Server = mapTmp["server"].(string)
+	}
+	if mapTmp["secondary-server"] != nil {
+		output.SecondaryServer = mapTmp["secondary-server"].(string)
+	}
+	if mapTmp["tertiary-server"] != nil {
+		output.TertiaryServer = mapTmp["tertiary-server"].(string)
+	}
+	if mapTmp["port"] != nil {
+		output.Port = int(mapTmp["port"].(float64))
+	}
+	if mapTmp["source-ip"] != nil {
+		output.SourceIP = mapTmp["source-ip"].(string)
+	}
+	if mapTmp["cnid"] != nil {
+		output.Cnid = mapTmp["cnid"].(string)
+	}
+	if mapTmp["dn"] != nil {
+		output.Dn = mapTmp["dn"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["username"] != nil {
+		output.Username = mapTmp["username"].(string)
+	}
+	if mapTmp["group-member-check"] != nil {
+		output.GroupMemberCheck = mapTmp["group-member-check"].(string)
+	}
+	if mapTmp["secure"] != nil {
+		output.Secure = mapTmp["secure"].(string)
+	}
+	if mapTmp["ca-cert"] != nil {
+		output.CaCert = mapTmp["ca-cert"].(string)
+	}
+	if mapTmp["password-expiry-warning"] != nil {
+		output.PasswordExpiryWarning = mapTmp["password-expiry-warning"].(string)
+	}
+	if mapTmp["password-renewal"] != nil {
+		output.PasswordRenewal = mapTmp["password-renewal"].(string)
+	}
+}
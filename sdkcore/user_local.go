@@ -0,0 +1,386 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONUserLocal contains the parameters for Create and Update API function
+// Type is "password", "radius", "tacacs+" or "ldap", the server of the type authenticates the user.
+// Passwd is write only, Update keeps the current password when it is empty.
+type JSONUserLocal struct {
+	Name         string `json:"name"`
+	ID           int    `json:"id,omitempty"`
+	Status       string `json:"status"`
+	Type         string `json:"type"`
+	Passwd       string `json:"passwd,omitempty"`
+	LdapServer   string `json:"ldap-server"`
+	RadiusServer string `json:"radius-server"`
+	TacacsServer string `json:"tacacs+-server"`
+	TwoFactor    string `json:"two-factor"`
+	Fortitoken   string `json:"fortitoken,omitempty"`
+	EmailTo      string `json:"email-to"`
+	SmsPhone     string `json:"sms-phone,omitempty"`
+}
+
+// JSONCreateUserLocalOutput contains the output results for Create API function
+type JSONCreateUserLocalOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateUserLocalOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateUserLocalOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateUserLocal API operation for FortiOS creates a new local user for firewall policies and user groups.
+// Returns the index value of the local user and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - local chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateUserLocal(params *JSONUserLocal) (output *JSONCreateUserLocalOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/user/local"
+	output = &JSONCreateUserLocalOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateUserLocal API operation for FortiOS updates the specified local user for firewall policies and user groups.
+// Returns the index value of the local user and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - local chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateUserLocal(params *JSONUserLocal, mkey string) (output *JSONUpdateUserLocalOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/user/local"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateUserLocalOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteUserLocal API operation for FortiOS deletes the specified local user for firewall policies and user groups.
+// Returns error for service API and SDK errors.
+// See the user - local chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteUserLocal(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/user/local"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadUserLocal API operation for FortiOS gets the local user for firewall policies and user groups
+// with the specified index value.
+// Returns the requested local user value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - local chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadUserLocal(mkey string) (output *JSONUserLocal, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/user/local"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONUserLocal{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillUserLocal(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListUserLocals API operation for FortiOS gets all the local users for firewall policies and user groups.
+// Returns the local users when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - local chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListUserLocals() (output []*JSONUserLocal, err error) {
+	results, err := c.listCmdbTable("user/local")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONUserLocal, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONUserLocal{}
+		fillUserLocal(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillUserLocal fills output from a local user of the response
+func fillUserLocal(output *JSONUserLocal, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["id"] != nil {
+		output.ID = int(mapTmp["id"].(float64))
+	}
+	if mapTmp["status"] != nil {
+		output.Status = mapTmp["status"].(string)
+	}
+	if mapTmp["type"] != nil {
+		output.Type = mapTmp["type"].(string)
+	}
+	if mapTmp["ldap-server"] != nil {
+		output.LdapServer = mapTmp["ldap-server"].(string)
+	}
+	if mapTmp["radius-server"] != nil {
+		output.RadiusServer = mapTmp["radius-server"].(string)
+	}
+	if mapTmp["tacacs+-server"] != nil {
+		output.TacacsServer = mapTmp["tacacs+-server"].(string)
+	}
+	if mapTmp["two-factor"] != nil {
+		output.TwoFactor = mapTmp["two-factor"].(string)
+	}
+	if mapTmp["fortitoken"] != nil {
+		output.Fortitoken = mapTmp["fortitoken"].(string)
+	}
+	if mapTmp["email-to"] != nil {
+		output.EmailTo = mapTmp["email-to"].(string)
+	}
+	if mapTmp["sms-phone"] != nil {
+		output.SmsPhone = mapTmp["sms-phone"].(string)
+	}
+}
@@ -0,0 +1,384 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONUserRadius contains the parameters for Create and Update API function
+// AuthType is "auto", "ms_chap_v2", "ms_chap", "chap" or "pap". The secrets are write only,
+// Update keeps the current secrets when they are empty.
+type JSONUserRadius struct {
+	Name            string `json:"name"`
+	Server          string `json:"server"`
+	Secret          string `json:"secret,omitempty"`
+	SecondaryServer string `json:"secondary-server"`
+	SecondarySecret string `json:"secondary-secret,omitempty"`
+	TertiaryServer  string `json:"tertiary-server"`
+	TertiarySecret  string `json:"tertiary-secret,omitempty"`
+	AuthType        string `json:"auth-type"`
+	RadiusPort      int    `json:"radius-port,omitempty"`
+	NasIP           string `json:"nas-ip"`
+	SourceIP        string `json:"source-ip"`
+	Timeout         int    `json:"timeout,omitempty"`
+	AllUsergroup    string `json:"all-usergroup"`
+}
+
+// JSONCreateUserRadiusOutput contains the output results for Create API function
+type JSONCreateUserRadiusOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateUserRadiusOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateUserRadiusOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateUserRadius API operation for FortiOS creates a new RADIUS server for user authentication.
+// Returns the index value of the RADIUS server and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - radius chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateUserRadius(params *JSONUserRadius) (output *JSONCreateUserRadiusOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/user/radius"
+	output = &JSONCreateUserRadiusOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateUserRadius API operation for FortiOS updates the specified RADIUS server for user authentication.
+// Returns the index value of the RADIUS server and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - radius chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateUserRadius(params *JSONUserRadius, mkey string) (output *JSONUpdateUserRadiusOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/user/radius"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateUserRadiusOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteUserRadius API operation for FortiOS deletes the specified RADIUS server for user authentication.
+// Returns error for service API and SDK errors.
+// See the user - radius chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteUserRadius(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/user/radius"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadUserRadius API operation for FortiOS gets the RADIUS server for user authentication
+// with the specified index value.
+// Returns the requested RADIUS server value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - radius chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadUserRadius(mkey string) (output *JSONUserRadius, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/user/radius"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONUserRadius{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillUserRadius(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListUserRadiuss API operation for FortiOS gets all the RADIUS servers for user authentication.
+// Returns the RADIUS servers when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - radius chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListUserRadiuss() (output []*JSONUserRadius, err error) {
+	results, err := c.listCmdbTable("user/radius")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONUserRadius, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONUserRadius{}
+		fillUserRadius(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillUserRadius fills output from a RADIUS server of the response
+func fillUserRadius(output *JSONUserRadius, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["server"] != nil {
+		output.Server = mapTmp["server"].(string)
+	}
+	if mapTmp["secondary-server"] != nil {
+		output.SecondaryServer = mapTmp["secondary-server"].(string)
+	}
+	if mapTmp["tertiary-server"] != nil {
+		output.TertiaryServer = mapTmp["tertiary-server"].(string)
+	}
+	if mapTmp["auth-type"] != nil {
+		output.AuthType = mapTmp["auth-type"].(string)
+	}
+	if mapTmp["radius-port"] != nil {
+		output.RadiusPort = int(mapTmp["radius-port"].(float64))
+	}
+	if mapTmp["nas-ip"] != nil {
+		output.NasIP = mapTmp["nas-ip"].(string)
+	}
+	if mapTmp["source-ip"] != nil {
+		output.SourceIP = mapTmp["source-ip"].(string)
+	}
+	if mapTmp["timeout"] != nil {
+		output.Timeout = int(mapTmp["timeout"].(float64))
+	}
+	if mapTmp["all-usergroup"] != nil {
+		output.AllUsergroup = mapTmp["all-usergroup"].(string)
+	}
+}
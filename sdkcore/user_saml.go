@@ -0,0 +1,388 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONUserSaml contains the parameters for Create and Update API function
+// The FortiGate is the service provider, the idp attributes describe the identity provider.
+type JSONUserSaml struct {
+	Name               string `json:"name"`
+	Cert               string `json:"cert"`
+	EntityID           string `json:"entity-id"`
+	SingleSignOnURL    string `json:"single-sign-on-url"`
+	SingleLogoutURL    string `json:"single-logout-url"`
+	IdpEntityID        string `json:"idp-entity-id"`
+	IdpSingleSignOnURL string `json:"idp-single-sign-on-url"`
+	IdpSingleLogoutURL string `json:"idp-single-logout-url"`
+	IdpCert            string `json:"idp-cert"`
+	UserName           string `json:"user-name"`
+	GroupName          string `json:"group-name"`
+	DigestMethod       string `json:"digest-method,omitempty"`
+}
+
+// JSONCreateUserSamlOutput contains the output results for Create API function
+type JSONCreateUserSamlOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateUserSamlOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateUserSamlOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateUserSaml API operation for FortiOS creates a new SAML server for single sign-on.
+// Returns the index value of the SAML server and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - saml chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateUserSaml(params *JSONUserSaml) (output *JSONCreateUserSamlOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/user/saml"
+	output = &JSONCreateUserSamlOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateUserSaml API operation for FortiOS updates the specified SAML server for single sign-on.
+// Returns the index value of the SAML server and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - saml chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateUserSaml(params *JSONUserSaml, mkey string) (output *JSONUpdateUserSamlOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/user/saml"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateUserSamlOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteUserSaml API operation for FortiOS deletes the specified SAML server for single sign-on.
+// Returns error for service API and SDK errors.
+// See the user - saml chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteUserSaml(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/user/saml"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadUserSaml API operation for FortiOS gets the SAML server for single sign-on
+// with the specified index value.
+// Returns the requested SAML server value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - saml chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadUserSaml(mkey string) (output *JSONUserSaml, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/user/saml"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONUserSaml{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillUserSaml(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListUserSamls API operation for FortiOS gets all the SAML servers for single sign-on.
+// Returns the SAML servers when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - saml chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListUserSamls() (output []*JSONUserSaml, err error) {
+	results, err := c.listCmdbTable("user/saml")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONUserSaml, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONUserSaml{}
+		fillUserSaml(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillUserSaml fills output from a SAML server of the response
+func fillUserSaml(output *JSONUserSaml, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["cert"] != nil {
+		output.Cert = mapTmp["cert"].(string)
+	}
+	if mapTmp["entity-id"] != nil {
+		output.EntityID = mapTmp["entity-id"].(string)
+	}
+	if mapTmp["single-sign-on-url"] != nil {
+		output.SingleSignOnURL = mapTmp["single-sign-on-url"].(string)
+	}
+	if mapTmp["single-logout-url"] != nil {
+		output.SingleLogoutURL = mapTmp["single-logout-url"].(string)
+	}
+	if mapTmp["idp-entity-id"] != nil {
+		output.IdpEntityID = mapTmp["idp-entity-id"].(string)
+	}
+	if mapTmp["idp-single-sign-on-url"] != nil {
+		output.IdpSingleSignOnURL = mapTmp["idp-single-sign-on-url"].(string)
+	}
+	if mapTmp["idp-single-logout-url"] != nil {
+		output.IdpSingleLogoutURL = mapTmp["idp-single-logout-url"].(string)
+	}
+	if mapTmp["idp-cert"] != nil {
+		output.IdpCert = mapTmp["idp-cert"].(string)
+	}
+	if mapTmp["user-name"] != nil {
+		output.UserName = mapTmp["user-name"].(string)
+	}
+	if mapTmp["group-name"] != nil {
+		output.GroupName = mapTmp["group-name"].(string)
+	}
+	if mapTmp["digest-method"] != nil {
+		output.DigestMethod = mapTmp["digest-method"].(string)
+	}
+}
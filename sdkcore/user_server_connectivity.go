@@ -0,0 +1,80 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONUserServerConnectivity contains the parameters for the TestConnectivity API functions
+// Username and Password are optional, when set the test also authenticates this user.
+// They are sent in the body of the request, use a dedicated test account all the same.
+type JSONUserServerConnectivity struct {
+	Username string
+	Password string
+}
+
+// JSONUserServerConnectivityOutput contains the output results for the TestConnectivity API functions
+// Message is the reason of the failure returned by FortiOS, such as "Can't contact LDAP server".
+type JSONUserServerConnectivityOutput struct {
+	Success bool
+	Message string
+}
+
+// TestUserLdapConnectivity API operation for FortiOS checks that the FortiGate can reach and bind
+// the LDAP server with the specified name, and optionally authenticate a user with it.
+// A failed test is not an error, it returns Success false.
+// Returns the test result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the diagnose test authserver ldap chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) TestUserLdapConnectivity(mkey string, params *JSONUserServerConnectivity) (output *JSONUserServerConnectivityOutput, err error) {
+	return c.testUserServerConnectivity("/api/v2/monitor/user/ldap/test-connect", mkey, params)
+}
+
+// TestUserRadiusConnectivity API operation for FortiOS checks that the RADIUS server with the
+// specified name answers the FortiGate, and optionally authenticates a user with it.
+// A failed test is not an error, it returns Success false.
+// Returns the test result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the diagnose test authserver radius chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) TestUserRadiusConnectivity(mkey string, params *JSONUserServerConnectivity) (output *JSONUserServerConnectivityOutput, err error) {
+	return c.testUserServerConnectivity("/api/v2/monitor/user/radius/test-connect", mkey, params)
+}
+
+// testUserServerConnectivity runs a connectivity test monitor of an authentication server
+func (c *FortiSDKClient) testUserServerConnectivity(path string, mkey string, params *JSONUserServerConnectivity) (output *JSONUserServerConnectivityOutput, err error) {
+	HTTPMethod := "POST"
+
+	data := map[string]interface{}{"mkey": mkey}
+	if params != nil && params.Username != "" {
+		data["user"] = params.Username
+		data["password"] = params.Password
+	}
+	locJSON, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+
+	req := c.NewRequest(HTTPMethod, path, nil, bytes.NewBuffer(locJSON))
+	result, err := c.sendRequest(req)
+	if err != nil {
+		return
+	}
+
+	mapTmp, _ := result["results"].(map[string]interface{})
+	if mapTmp == nil {
+		err = fmt.Errorf("cannot get the results from the response")
+		return
+	}
+
+	output = &JSONUserServerConnectivityOutput{}
+	if mapTmp["status"] != nil {
+		output.Success = mapTmp["status"] == "success"
+		output.Message = fmt.Sprint(mapTmp["status"])
+	}
+	if mapTmp["message"] != nil {
+		output.Message = fmt.Sprint(mapTmp["message"])
+	}
+
+	return
+}
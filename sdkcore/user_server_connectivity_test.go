@@ -0,0 +1,48 @@
+package forticlient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/fgtdev/fortios-sdk-go/auth"
+)
+
+func TestUserLdapConnectivityCredentialsInBody(t *testing.T) {
+	var query string
+	var body map[string]interface{}
+
+	device := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": "success", "http_status": 200,
+			"results": map[string]interface{}{"status": "error", "message": "Can't contact LDAP server"},
+		})
+	}))
+	defer device.Close()
+
+	u, err := url.Parse(device.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewClient(auth.NewAuth(u.Host, "token", "", ""), device.Client())
+
+	output, err := c.TestUserLdapConnectivity("ldap1", &JSONUserServerConnectivity{Username: "probe", Password: "s3cret"})
+	if err != nil {
+		t.Fatalf("TestUserLdapConnectivity() error = %v", err)
+	}
+	if output.Success || output.Message != "Can't contact LDAP server" {
+		t.Errorf("TestUserLdapConnectivity() = %+v, want the failure message", output)
+	}
+
+	if strings.Contains(query, "s3cret") || strings.Contains(query, "probe") {
+		t.Errorf("credentials sent in the query %q", query)
+	}
+	if body["mkey"] != "ldap1" || body["user"] != "probe" || body["password"] != "s3cret" {
+		t.Errorf("body = %v, want the server and the credentials", body)
+	}
+}
@@ -0,0 +1,376 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONUserTacacs contains the parameters for Create and Update API function
+// AuthenType is "auto", "mschap", "chap", "pap" or "ascii". The keys are write only,
+// Update keeps the current keys when they are empty.
+type JSONUserTacacs struct {
+	Name            string `json:"name"`
+	Server          string `json:"server"`
+	Key             string `json:"key,omitempty"`
+	SecondaryServer string `json:"secondary-server"`
+	SecondaryKey    string `json:"secondary-key,omitempty"`
+	TertiaryServer  string `json:"tertiary-server"`
+	TertiaryKey     string `json:"tertiary-key,omitempty"`
+	Port            int    `json:"port"`
+	AuthenType      string `json:"authen-type"`
+	Authorization   string `json:"authorization"`
+	SourceIP        string `json:"source-ip"`
+}
+
+// JSONCreateUserTacacsOutput contains the output results for Create API function
+type JSONCreateUserTacacsOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateUserTacacsOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateUserTacacsOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateUserTacacs API operation for FortiOS creates a new TACACS+ server for user authentication.
+// Returns the index value of the TACACS+ server and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - tacacs+ chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateUserTacacs(params *JSONUserTacacs) (output *JSONCreateUserTacacsOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/user/tacacs+"
+	output = &JSONCreateUserTacacsOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateUserTacacs API operation for FortiOS updates the specified TACACS+ server for user authentication.
+// Returns the index value of the TACACS+ server and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - tacacs+ chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateUserTacacs(params *JSONUserTacacs, mkey string) (output *JSONUpdateUserTacacsOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/user/tacacs+"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateUserTacacsOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteUserTacacs API operation for FortiOS deletes the specified TACACS+ server for user authentication.
+// Returns error for service API and SDK errors.
+// See the user - tacacs+ chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteUserTacacs(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/user/tacacs+"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadUserTacacs API operation for FortiOS gets the TACACS+ server for user authentication
+// with the specified index value.
+// Returns the requested TACACS+ server value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - tacacs+ chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadUserTacacs(mkey string) (output *JSONUserTacacs, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/user/tacacs+"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONUserTacacs{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillUserTacacs(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListUserTacacss API operation for FortiOS gets all the TACACS+ servers for user authentication.
+// Returns the TACACS+ servers when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the user - tacacs+ chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListUserTacacss() (output []*JSONUserTacacs, err error) {
+	results, err := c.listCmdbTable("user/tacacs+")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONUserTacacs, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONUserTacacs{}
+		fillUserTacacs(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillUserTacacs fills output from a TACACS+ server of the response
+func fillUserTacacs(output *JSONUserTacacs, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["server"] != nil {
+		output.Server = mapTmp["server"].(string)
+	}
+	if mapTmp["secondary-server"] != nil {
+		output.SecondaryServer = mapTmp["secondary-server"].(string)
+	}
+	if mapTmp["tertiary-server"] != nil {
+		output.TertiaryServer = mapTmp["tertiary-server"].(string)
+	}
+	if mapTmp["port"] != nil {
+		output.Port = int(mapTmp["port"].(float64))
+	}
+	if mapTmp["authen-type"] != nil {
+		output.AuthenType = mapTmp["authen-type"].(string)
+	}
+	if mapTmp["authorization"] != nil {
+		output.Authorization = mapTmp["authorization"].(string)
+	}
+	if mapTmp["source-ip"] != nil {
+		output.SourceIP = mapTmp["source-ip"].(string)
+	}
+}
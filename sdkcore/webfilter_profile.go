@@ -0,0 +1,514 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONWebfilterProfile contains the parameters for Create and Update API function
+type JSONWebfilterProfile struct {
+	Name            string                  `json:"name"`
+	Comment         string                  `json:"comment"`
+	FeatureSet      string                  `json:"feature-set,omitempty"`
+	ReplacemsgGroup string                  `json:"replacemsg-group"`
+	Options         string                  `json:"options"`
+	HTTPSReplacemsg string                  `json:"https-replacemsg"`
+	OvrdPerm        string                  `json:"ovrd-perm"`
+	PostAction      string                  `json:"post-action"`
+	LogAllURL       string                  `json:"log-all-url"`
+	ExtendedLog     string                  `json:"extended-log"`
+	WebContentLog   string                  `json:"web-content-log"`
+	WebFtgdErrLog   string                  `json:"web-ftgd-err-log"`
+	Web             *WebfilterProfileWeb    `json:"web,omitempty"`
+	FtgdWf          *WebfilterProfileFtgdWf `json:"ftgd-wf,omitempty"`
+}
+
+// WebfilterProfileWeb contains the static URL and content filtering parameters of a web filter profile
+type WebfilterProfileWeb struct {
+	Blocklist         string `json:"blocklist,omitempty"`
+	Allowlist         string `json:"allowlist,omitempty"`
+	URLFilterTable    int    `json:"urlfilter-table,omitempty"`
+	ContentHeaderList int    `json:"content-header-list,omitempty"`
+	BwordTable        int    `json:"bword-table,omitempty"`
+	BwordThreshold    int    `json:"bword-threshold,omitempty"`
+	SafeSearch        string `json:"safe-search,omitempty"`
+	YoutubeRestrict   string `json:"youtube-restrict,omitempty"`
+	LogSearch         string `json:"log-search,omitempty"`
+}
+
+// WebfilterProfileFtgdWf contains the FortiGuard category filtering parameters of a web filter profile
+type WebfilterProfileFtgdWf struct {
+	Options            string                         `json:"options,omitempty"`
+	Filters            []WebfilterProfileFtgdWfFilter `json:"filters,omitempty"`
+	MaxQuotaTimeout    int                            `json:"max-quota-timeout,omitempty"`
+	RateJavascriptUrls string                         `json:"rate-javascript-urls,omitempty"`
+	RateCSSUrls        string                         `json:"rate-css-urls,omitempty"`
+	RateCrlUrls        string                         `json:"rate-crl-urls,omitempty"`
+}
+
+// WebfilterProfileFtgdWfFilter contains the action of a FortiGuard category of a web filter profile
+type WebfilterProfileFtgdWfFilter struct {
+	ID           int        `json:"id,omitempty"`
+	Category     int        `json:"category"`
+	Action       string     `json:"action"`
+	Log          string     `json:"log"`
+	WarnDuration string     `json:"warn-duration,omitempty"`
+	AuthUsrGrp   MultValues `json:"auth-usr-grp,omitempty"`
+}
+
+// JSONCreateWebfilterProfileOutput contains the output results for Create API function
+type JSONCreateWebfilterProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateWebfilterProfileOutput contains the output results for Update API function
+// Attention: Considering scalability, the previous structure and the current structure may change differently
+type JSONUpdateWebfilterProfileOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateWebfilterProfile API operation for FortiOS creates a new web filter profile for firewall policies.
+// Returns the index value of the web filter profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the webfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateWebfilterProfile(params *JSONWebfilterProfile) (output *JSONCreateWebfilterProfileOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/webfilter/profile"
+	output = &JSONCreateWebfilterProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateWebfilterProfile API operation for FortiOS updates the specified web filter profile for firewall policies.
+// Returns the index value of the web filter profile and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the webfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateWebfilterProfile(params *JSONWebfilterProfile, mkey string) (output *JSONUpdateWebfilterProfileOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/webfilter/profile"
+	path += "/" + EscapeURLString(mkey)
+	output = &JSONUpdateWebfilterProfileOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteWebfilterProfile API operation for FortiOS deletes the specified web filter profile for firewall policies.
+// Returns error for service API and SDK errors.
+// See the webfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteWebfilterProfile(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/webfilter/profile"
+	path += "/" + EscapeURLString(mkey)
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadWebfilterProfile API operation for FortiOS gets the web filter profile for firewall policies
+// with the specified index value.
+// Returns the requested web filter profile value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the webfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadWebfilterProfile(mkey string) (output *JSONWebfilterProfile, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/webfilter/profile"
+	path += "/" + EscapeURLString(mkey)
+
+	output = &JSONWebfilterProfile{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillWebfilterProfile(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListWebfilterProfiles API operation for FortiOS gets all the web filter profiles for firewall policies.
+// Returns the web filter profiles when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the webfilter - profile chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListWebfilterProfiles() (output []*JSONWebfilterProfile, err error) {
+	results, err := c.listCmdbTable("webfilter/profile")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONWebfilterProfile, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONWebfilterProfile{}
+		fillWebfilterProfile(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillWebfilterProfile fills output from a web filter profile of the response
+func fillWebfilterProfile(output *JSONWebfilterProfile, mapTmp map[string]interface{}) {
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["feature-set"] != nil {
+		output.FeatureSet = mapTmp["feature-set"].(string)
+	}
+	if mapTmp["replacemsg-group"] != nil {
+		output.ReplacemsgGroup = mapTmp["replacemsg-group"].(string)
+	}
+	if mapTmp["options"] != nil {
+		output.Options = mapTmp["options"].(string)
+	}
+	if mapTmp["https-replacemsg"] != nil {
+		output.HTTPSReplacemsg = mapTmp["https-replacemsg"].(string)
+	}
+	if mapTmp["ovrd-perm"] != nil {
+		output.OvrdPerm = mapTmp["ovrd-perm"].(string)
+	}
+	if mapTmp["post-action"] != nil {
+		output.PostAction = mapTmp["post-action"].(string)
+	}
+	if mapTmp["log-all-url"] != nil {
+		output.LogAllURL = mapTmp["log-all-url"].(string)
+	}
+	if mapTmp["extended-log"] != nil {
+		output.ExtendedLog = mapTmp["extended-log"].(string)
+	}
+	if mapTmp["web-content-log"] != nil {
+		output.WebContentLog = mapTmp["web-content-log"].(string)
+	}
+	if mapTmp["web-ftgd-err-log"] != nil {
+		output.WebFtgdErrLog = mapTmp["web-ftgd-err-log"].(string)
+	}
+	if mapTmp["web"] != nil {
+		c := mapTmp["web"].(map[string]interface{})
+		m := &WebfilterProfileWeb{}
+		if c["blocklist"] != nil {
+			m.Blocklist = c["blocklist"].(string)
+		}
+		if c["allowlist"] != nil {
+			m.Allowlist = c["allowlist"].(string)
+		}
+		if c["urlfilter-table"] != nil {
+			m.URLFilterTable = int(c["urlfilter-table"].(float64))
+		}
+		if c["content-header-list"] != nil {
+			m.ContentHeaderList = int(c["content-header-list"].(float64))
+		}
+		if c["bword-table"] != nil {
+			m.BwordTable = int(c["bword-table"].(float64))
+		}
+		if c["bword-threshold"] != nil {
+			m.BwordThreshold = int(c["bword-threshold"].(float64))
+		}
+		if c["safe-search"] != nil {
+			m.SafeSearch = c["safe-search"].(string)
+		}
+		if c["youtube-restrict"] != nil {
+			m.YoutubeRestrict = c["youtube-restrict"].(string)
+		}
+		if c["log-search"] != nil {
+			m.LogSearch = c["log-search"].(string)
+		}
+		output.Web = m
+	}
+	if mapTmp["ftgd-wf"] != nil {
+		c := mapTmp["ftgd-wf"].(map[string]interface{})
+		m := &WebfilterProfileFtgdWf{}
+		if c["options"] != nil {
+			m.Options = c["options"].(string)
+		}
+		if c["filters"] != nil {
+			member := c["filters"].([]interface{})
+
+			var members []WebfilterProfileFtgdWfFilter
+			for _, v := range member {
+				c := v.(map[string]interface{})
+				m := WebfilterProfileFtgdWfFilter{}
+				if c["id"] != nil {
+					m.ID = int(c["id"].(float64))
+				}
+				if c["category"] != nil {
+					m.Category = int(c["category"].(float64))
+				}
+				if c["action"] != nil {
+					m.Action = c["action"].(string)
+				}
+				if c["log"] != nil {
+					m.Log = c["log"].(string)
+				}
+				if c["warn-duration"] != nil {
+					m.WarnDuration = c["warn-duration"].(string)
+				}
+				if c["auth-usr-grp"] != nil {
+					member := c["auth-usr-grp"].([]interface{})
+
+					var members []MultValue
+					for _, v := range member {
+						c := v.(map[string]interface{})
+
+						members = append(members,
+							MultValue{
+								Name: c["name"].(string),
+							})
+					}
+					m.AuthUsrGrp = members
+				}
+				members = append(members, m)
+			}
+			m.Filters = members
+		}
+		if c["max-quota-timeout"] != nil {
+			m.MaxQuotaTimeout = int(c["max-quota-timeout"].(float64))
+		}
+		if c["rate-javascript-urls"] != nil {
+			m.RateJavascriptUrls = c["rate-javascript-urls"].(string)
+		}
+		if c["rate-css-urls"] != nil {
+			m.RateCSSUrls = c["rate-css-urls"].(string)
+		}
+		if c["rate-crl-urls"] != nil {
+			m.RateCrlUrls = c["rate-crl-urls"].(string)
+		}
+		output.FtgdWf = m
+	}
+}
@@ -0,0 +1,400 @@
+package forticlient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/fgtdev/fortios-sdk-go/util"
+)
+
+// JSONWebfilterURLFilter contains the parameters for Create and Update API function
+type JSONWebfilterURLFilter struct {
+	ID                 int                       `json:"id,omitempty"`
+	Name               string                    `json:"name"`
+	Comment            string                    `json:"comment"`
+	OneArmIpsUrlfilter string                    `json:"one-arm-ips-urlfilter,omitempty"`
+	IPAddrBlock        string                    `json:"ip-addr-block,omitempty"`
+	Entries            []WebfilterURLFilterEntry `json:"entries"`
+}
+
+// WebfilterURLFilterEntry contains an URL of a web filter URL list
+// Type is "simple", "regex" or "wildcard", Action is "exempt", "block", "allow" or "monitor".
+type WebfilterURLFilterEntry struct {
+	ID     int    `json:"id,omitempty"`
+	URL    string `json:"url"`
+	Type   string `json:"type"`
+	Action string `json:"action"`
+	Status string `json:"status"`
+	Exempt string `json:"exempt,omitempty"`
+}
+
+// JSONCreateWebfilterURLFilterOutput contains the output results for Create API function
+type JSONCreateWebfilterURLFilterOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       float64 `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// JSONUpdateWebfilterURLFilterOutput contains the output results for Update API function
+// Attention: The RESTful API changed the Mkey type from float64 in CREATE to string in UPDATE!
+type JSONUpdateWebfilterURLFilterOutput struct {
+	Vdom       string  `json:"vdom"`
+	Mkey       string  `json:"mkey"`
+	Status     string  `json:"status"`
+	HTTPStatus float64 `json:"http_status"`
+}
+
+// CreateWebfilterURLFilter API operation for FortiOS creates a new web filter URL list for web filter profiles.
+// Returns the index value of the web filter URL list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the webfilter - urlfilter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) CreateWebfilterURLFilter(params *JSONWebfilterURLFilter) (output *JSONCreateWebfilterURLFilterOutput, err error) {
+	HTTPMethod := "POST"
+	path := "/api/v2/cmdb/webfilter/urlfilter"
+	output = &JSONCreateWebfilterURLFilterOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(float64)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// UpdateWebfilterURLFilter API operation for FortiOS updates the specified web filter URL list for web filter profiles.
+// Returns the index value of the web filter URL list and execution result when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the webfilter - urlfilter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) UpdateWebfilterURLFilter(params *JSONWebfilterURLFilter, mkey string) (output *JSONUpdateWebfilterURLFilterOutput, err error) {
+	HTTPMethod := "PUT"
+	path := "/api/v2/cmdb/webfilter/urlfilter"
+	path += "/" + mkey
+	output = &JSONUpdateWebfilterURLFilterOutput{}
+	locJSON, err := json.Marshal(params)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+
+	bytes := bytes.NewBuffer(locJSON)
+	req := c.NewRequest(HTTPMethod, path, nil, bytes)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["vdom"] != nil {
+			output.Vdom = result["vdom"].(string)
+		}
+		if result["mkey"] != nil {
+			output.Mkey = result["mkey"].(string)
+		}
+		if result["status"] != nil {
+			if result["status"] != "success" {
+				if result["error"] != nil {
+					err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+				} else {
+					err = fmt.Errorf("status is %s and error no is not found", result["status"])
+				}
+
+				if result["http_status"] != nil {
+					err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+				} else {
+					err = fmt.Errorf("%s, and http_status no is not found", err)
+				}
+
+				return
+			}
+			output.Status = result["status"].(string)
+		} else {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+		if result["http_status"] != nil {
+			output.HTTPStatus = result["http_status"].(float64)
+		}
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// DeleteWebfilterURLFilter API operation for FortiOS deletes the specified web filter URL list for web filter profiles.
+// Returns error for service API and SDK errors.
+// See the webfilter - urlfilter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) DeleteWebfilterURLFilter(mkey string) (err error) {
+	HTTPMethod := "DELETE"
+	path := "/api/v2/cmdb/webfilter/urlfilter"
+	path += "/" + mkey
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ReadWebfilterURLFilter API operation for FortiOS gets the web filter URL list for web filter profiles
+// with the specified index value.
+// Returns the requested web filter URL list value when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the webfilter - urlfilter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ReadWebfilterURLFilter(mkey string) (output *JSONWebfilterURLFilter, err error) {
+	HTTPMethod := "GET"
+	path := "/api/v2/cmdb/webfilter/urlfilter"
+	path += "/" + mkey
+
+	output = &JSONWebfilterURLFilter{}
+
+	req := c.NewRequest(HTTPMethod, path, nil, nil)
+	err = req.Send()
+	if err != nil || req.HTTPResponse == nil {
+		err = fmt.Errorf("cannot send request %s", err)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.HTTPResponse.Body)
+	if err != nil || body == nil {
+		err = fmt.Errorf("cannot get response body %s", err)
+		return
+	}
+	log.Printf("FOS-fortios reading response: %s", string(body))
+
+	var result map[string]interface{}
+	json.Unmarshal([]byte(string(body)), &result)
+
+	req.HTTPResponse.Body.Close()
+
+	if result != nil {
+		if result["http_status"] == nil {
+			err = fmt.Errorf("cannot get http_status from the response")
+			return
+		}
+
+		if result["http_status"] == 404.0 {
+			output = nil
+			return
+		}
+
+		if result["status"] == nil {
+			err = fmt.Errorf("cannot get status from the response")
+			return
+		}
+
+		if result["status"] != "success" {
+			if result["error"] != nil {
+				err = fmt.Errorf("status is %s and error no is %.0f", result["status"], result["error"])
+			} else {
+				err = fmt.Errorf("status is %s and error no is not found", result["status"])
+			}
+
+			if result["http_status"] != nil {
+				err = fmt.Errorf("%s, details: %s", err, util.HttpStatus2Str(int(result["http_status"].(float64))))
+			} else {
+				err = fmt.Errorf("%s, and http_status no is not found", err)
+			}
+
+			return
+		}
+
+		mapTmp := (result["results"].([]interface{}))[0].(map[string]interface{})
+
+		if mapTmp == nil {
+			err = fmt.Errorf("cannot get the results from the response")
+			return
+		}
+
+		fillWebfilterURLFilter(output, mapTmp)
+	} else {
+		err = fmt.Errorf("cannot get the right response")
+		return
+	}
+
+	return
+}
+
+// ListWebfilterURLFilters API operation for FortiOS gets all the web filter URL lists for web filter profiles.
+// Returns the web filter URL lists when the request executes successfully.
+// Returns error for service API and SDK errors.
+// See the webfilter - urlfilter chapter in the FortiOS Handbook - CLI Reference.
+func (c *FortiSDKClient) ListWebfilterURLFilters() (output []*JSONWebfilterURLFilter, err error) {
+	results, err := c.listCmdbTable("webfilter/urlfilter")
+	if err != nil {
+		return
+	}
+
+	output = make([]*JSONWebfilterURLFilter, 0, len(results))
+	for _, mapTmp := range results {
+		v := &JSONWebfilterURLFilter{}
+		fillWebfilterURLFilter(v, mapTmp)
+		output = append(output, v)
+	}
+
+	return
+}
+
+// fillWebfilterURLFilter fills output from a web filter URL list of the response
+func fillWebfilterURLFilter(output *JSONWebfilterURLFilter, mapTmp map[string]interface{}) {
+	if mapTmp["id"] != nil {
+		output.ID = int(mapTmp["id"].(float64))
+	}
+	if mapTmp["name"] != nil {
+		output.Name = mapTmp["name"].(string)
+	}
+	if mapTmp["comment"] != nil {
+		output.Comment = mapTmp["comment"].(string)
+	}
+	if mapTmp["one-arm-ips-urlfilter"] != nil {
+		output.OneArmIpsUrlfilter = mapTmp["one-arm-ips-urlfilter"].(string)
+	}
+	if mapTmp["ip-addr-block"] != nil {
+		output.IPAddrBlock = mapTmp["ip-addr-block"].(string)
+	}
+	if mapTmp["entries"] != nil {
+		member := mapTmp["entries"].([]interface{})
+
+		var members []WebfilterURLFilterEntry
+		for _, v := range member {
+			c := v.(map[string]interface{})
+			m := WebfilterURLFilterEntry{}
+			if c["id"] != nil {
+				m.ID = int(c["id"].(float64))
+			}
+			if c["url"] != nil {
+				m.URL = c["url"].(string)
+			}
+			if c["type"] != nil {
+				m.Type = c["type"].(string)
+			}
+			if c["action"] != nil {
+				m.Action = c["action"].(string)
+			}
+			if c["status"] != nil {
+				m.Status = c["status"].(string)
+			}
+			if c["exempt"] != nil {
+				m.Exempt = c["exempt"].(string)
+			}
+			members = append(members, m)
+		}
+		output.Entries = members
+	}
+}